@@ -1,18 +1,154 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
 	"feature-base-starter-kit/internal/config"
+	"feature-base-starter-kit/internal/modules/article"
 	"feature-base-starter-kit/internal/router"
+	"feature-base-starter-kit/migrations"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/health"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/storage"
 	"feature-base-starter-kit/pkg/validation"
+
+	"github.com/gin-gonic/gin"
 )
 
+// Kullanim:
+//
+//	api [flags]          API sunucusunu baslatir (serve)
+//	api seed [flags]     ornek veri ekler, bkz. seed.go
+//	api purge [flags]    soft delete edilmis eski kayitlari kalici olarak siler, bkz. purge.go
 func main() {
-	cfg := config.LoadConfig()
+	cmd, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+
+	switch cmd {
+	case "serve":
+		runServe(args)
+	case "seed":
+		runSeed(args)
+	case "purge":
+		runPurge(args)
+	default:
+		log.Fatalf("Unknown command %q, available commands: serve, seed, purge", cmd)
+	}
+}
+
+func runServe(args []string) {
+	cfg, err := config.LoadConfig(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Secret alanlar maskelenerek yazdirilir
+	log.Printf("Loaded configuration:\n%s", cfg)
+
+	if cfg.Log.Level != "debug" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	validation.SetLocalesDir(cfg.I18n.LocalesDir)
+	validation.Init(cfg.I18n.Lang)
+	validation.SetPathStyle(validation.PathStyle(cfg.I18n.PathStyle))
+
+	// validation disi mesajlar, validation translator'larina eklenir
+	if err := i18n.Init(cfg.I18n.LocalesDir); err != nil {
+		log.Fatalf("Error loading messages: %v", err)
+	}
+
+	db := openDatabase(cfg)
+	defer db.Close()
+
+	health.RegisterReadiness("database", db.ReadinessCheck)
+	validation.SetRecordChecker(db) // async unique/exists kurallari veritabanina sorar
 
-	validation.Init(cfg.Lang)
+	store := openStorage(cfg)
+	health.RegisterReadiness("storage", func(ctx context.Context) (any, error) {
+		return cfg.Upload.Storage, store.Ping(ctx)
+	})
 
-	r := router.Setup(&cfg)
+	// SIGINT/SIGTERM: sunucu yeni istek almayi birakir, scheduler durur; db, ikisi de bittikten sonra (defer) kapanir
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// zamanlanmis makaleleri yayinlar, bkz. article.Scheduler
+	var background sync.WaitGroup
+	if cfg.Scheduler.Interval > 0 {
+		scheduler := article.NewScheduler(article.NewRepository(db), database.NewTxManager(db), cfg.Scheduler.Interval)
+		background.Go(func() { scheduler.Run(ctx) })
+	}
+
+	r := router.Setup(&cfg, db, store)
 	// pointer olarak gonderdik cunku config yapisi buyuk olabilir. Yani cfg.Lang gibi kullanmak yerine, pointer ile gonderip, icinde istedigimiz yere erisebiliriz.
 
-	r.Run(":" + cfg.Port)
+	srv := &http.Server{Addr: ":" + cfg.Server.Port, Handler: r}
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-serveErr:
+		log.Printf("Server stopped: %v", err)
+	case <-ctx.Done():
+		log.Printf("Shutting down")
+	}
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("Error shutting down server: %v", err)
+	}
+	background.Wait()
+}
+
+// shutdownTimeout: kapanista devam eden isteklerin tamamlanmasi icin beklenecek en uzun sure.
+const shutdownTimeout = 15 * time.Second
+
+// openDatabase: baglantiyi acar ve DB_AUTO_MIGRATE acik ise eksik migration'lari calistirir.
+func openDatabase(cfg config.Config) *database.DB {
+	db, err := database.Open(context.Background(), cfg.DB.Database())
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+	}
+
+	if cfg.DB.AutoMigrate {
+		if err := database.Migrate(context.Background(), db, migrations.FS); err != nil {
+			db.Close()
+			log.Fatalf("Error running migrations: %v", err)
+		}
+	}
+
+	return db
+}
+
+// openStorage: UPLOAD_STORAGE'a gore yuklenen dosyalarin saklanacagi yeri acar (S3 icin bucket yoksa olusturulur).
+func openStorage(cfg config.Config) storage.Storage {
+	var (
+		store storage.Storage
+		err   error
+	)
+	switch cfg.Upload.Storage {
+	case "s3":
+		store, err = storage.NewS3(context.Background(), cfg.Upload.S3())
+	default:
+		store, err = storage.NewLocal(cfg.Upload.Dir)
+	}
+	if err != nil {
+		log.Fatalf("Error opening upload storage: %v", err)
+	}
+	return store
 }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"feature-base-starter-kit/internal/config"
+	"feature-base-starter-kit/internal/modules/article"
+	"feature-base-starter-kit/internal/modules/user"
+	"feature-base-starter-kit/pkg/database"
+)
+
+// runPurge: soft delete edilmis ve saklama suresi dolmus makale ve kullanicilari kalici olarak siler.
+// Cron veya Kubernetes CronJob ile periyodik calistirilmak icindir.
+//
+//	go run ./cmd/api purge -retention 720h
+func runPurge(args []string) {
+	retention := 30 * 24 * time.Hour
+
+	cfg, err := config.LoadConfig(args, func(fs *flag.FlagSet) {
+		fs.DurationVar(&retention, "retention", retention, "hard-delete rows soft-deleted longer than this ago")
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if retention < 0 {
+		log.Fatalf("-retention must not be negative")
+	}
+
+	db := openDatabase(cfg)
+	defer db.Close()
+
+	before := time.Now().Add(-retention)
+	var articles, users, skipped int64
+
+	// once makaleler: makalesi kalan kullanicilar silinmez (bkz. user.Repository.Purge), saklama suresi dolan
+	// makaleler silindikten sonra yazarlari da ayni calistirmada silinebilir
+	err = database.NewTxManager(db).WithinTx(context.Background(), func(ctx context.Context) error {
+		var err error
+		if articles, err = article.NewRepository(db).Purge(ctx, before); err != nil {
+			return err
+		}
+		users, skipped, err = user.NewRepository(db).Purge(ctx, before)
+		return err
+	})
+	if err != nil {
+		log.Fatalf("Error purging deleted rows: %v", err)
+	}
+
+	log.Printf("Purged rows deleted before %s: articles=%d users=%d (skipped users with articles=%d)",
+		before.UTC().Format(time.RFC3339), articles, users, skipped)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"feature-base-starter-kit/internal/config"
+	"feature-base-starter-kit/pkg/seed"
+)
+
+// runSeed: ornek kullanici, kategori ve makale ekler. Ayni -seed degeri ile her zaman ayni veri uretilir,
+// tekrar calistirmak kopya olusturmaz (bkz. pkg/seed).
+//
+//	go run ./cmd/api seed -users 100 -articles 500 -seed 42
+func runSeed(args []string) {
+	opts := seed.DefaultOptions()
+
+	cfg, err := config.LoadConfig(args, func(fs *flag.FlagSet) {
+		fs.IntVar(&opts.Users, "users", opts.Users, "number of users to seed")
+		fs.IntVar(&opts.Categories, "categories", opts.Categories, "number of categories to seed")
+		fs.IntVar(&opts.Articles, "articles", opts.Articles, "number of articles to seed")
+		fs.IntVar(&opts.MaxCategoriesPerArticle, "max-categories", opts.MaxCategoriesPerArticle, "maximum categories per article")
+		fs.Uint64Var(&opts.Seed, "seed", opts.Seed, "random seed, the same seed produces the same data")
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db := openDatabase(cfg)
+	defer db.Close()
+
+	res, err := seed.Run(context.Background(), db, opts)
+	if err != nil {
+		log.Fatalf("Error seeding database: %v", err)
+	}
+
+	log.Printf("Seeded %s (seed=%d)", res, opts.Seed)
+}
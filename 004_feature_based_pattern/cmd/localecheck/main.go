@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/validation"
+)
+
+// localecheck: locale dosyalarinda dillere gore eksik anahtarlari raporlar.
+// Eksik anahtar varsa 1 ile cikar, CI'da kullanilabilir.
+//
+//	go run ./cmd/localecheck
+//	go run ./cmd/localecheck -dir ./locales
+func main() {
+	dir := flag.String("dir", "", "directory with <lang>.yaml files overriding the embedded ones")
+	flag.Parse()
+
+	validation.SetLocalesDir(*dir)
+
+	validationMissing, err := validation.CheckLocales()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	messageMissing, err := i18n.CheckLocales(*dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	if len(validationMissing) == 0 && len(messageMissing) == 0 {
+		fmt.Println("All locales are complete")
+		return
+	}
+
+	report("validation", validationMissing)
+	report("messages", messageMissing)
+	os.Exit(1)
+}
+
+func report(section string, missing map[string][]string) {
+	langs := make([]string, 0, len(missing))
+	for lang := range missing {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		fmt.Printf("%s %s: %s\n", section, lang, strings.Join(missing[lang], ", "))
+	}
+}
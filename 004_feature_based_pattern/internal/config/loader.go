@@ -0,0 +1,369 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/validation"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/goccy/go-yaml"
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ValidationError: gecersiz config alanlarinin tamami (ilk hatada durmaz).
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// LoadConfig: config'i katmanlar halinde yukler (bkz. Config) ve dogrular. args genelde os.Args[1:] olur.
+// extra: alt komutlara ozel flag'leri (orn: seed -users) ayni FlagSet'e tanimlamak icin.
+func LoadConfig(args []string, extra ...func(fs *flag.FlagSet)) (Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("api", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or TOML config file")
+	envFile := fs.String("env-file", ".env", "path to a .env file")
+	flagValues := registerFlags(fs, reflect.ValueOf(&cfg).Elem())
+	for _, register := range extra {
+		register(fs)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	// 2. config dosyasi
+	if *configFile != "" {
+		if err := loadFile(*configFile, &cfg); err != nil {
+			return cfg, err
+		}
+	}
+
+	// 3. .env dosyasi: ortam degiskenlerini ezmez, sadece eksik olanlari tamamlar
+	dotenv, err := godotenv.Read(*envFile)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) || isFlagSet(fs, "env-file") {
+			return cfg, fmt.Errorf("reading %s: %w", *envFile, err)
+		}
+		dotenv = map[string]string{}
+	}
+
+	// 3-4. .env ve ortam degiskenleri
+	lookupEnv := func(key string) (string, bool) {
+		if v, ok := os.LookupEnv(key); ok {
+			return v, true
+		}
+		v, ok := dotenv[key]
+		return v, ok
+	}
+	if err := applyEnv(reflect.ValueOf(&cfg).Elem(), lookupEnv); err != nil {
+		return cfg, err
+	}
+
+	// 5. flag'ler: sadece komut satirinda verilenler uygulanir
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		field, ok := flagValues[f.Name]
+		if !ok || flagErr != nil {
+			return
+		}
+		if err := setField(field, f.Value.String()); err != nil {
+			flagErr = fmt.Errorf("flag -%s: %w", f.Name, err)
+		}
+	})
+	if flagErr != nil {
+		return cfg, flagErr
+	}
+
+	// APP_LANG=TR veya "en " gibi degerler validation.Init'e normalize edilmis olarak gecer
+	cfg.I18n.Lang = normalizeLang(cfg.I18n.Lang)
+
+	return cfg, Validate(cfg)
+}
+
+// Validate: config'i validator engine ile dogrular, tum hatalari tek bir ValidationError'da toplar.
+func Validate(cfg Config) error {
+	var problems []string
+
+	if err := binding.Validator.ValidateStruct(&cfg); err != nil {
+		var ve validator.ValidationErrors
+		if !errors.As(err, &ve) {
+			return err
+		}
+		for _, fe := range ve {
+			problems = append(problems, describe(fe))
+		}
+	}
+
+	// host ve user sunucu tabanli veritabanlari icin DSN verilmediyse zorunludur (sqlite dosya yolu ile calisir)
+	if cfg.DB.Driver != "sqlite" && cfg.DB.DSN.Value() == "" {
+		if cfg.DB.Host == "" {
+			problems = append(problems, "db.host (DB_HOST): is required")
+		}
+		if cfg.DB.User == "" {
+			problems = append(problems, "db.user (DB_USER): is required")
+		}
+	}
+
+	// ayni anahtar iki role verilirse hangisinin gecerli olacagi belirsizdir
+	if len(cfg.Auth.Keys()) < countSet(cfg.Auth.APISecretKey, cfg.Auth.EditorKey, cfg.Auth.AuthorKey) {
+		problems = append(problems, "auth: api_secret_key, editor_key and author_key must be different")
+	}
+
+	// desteklenen diller validation paketinden gelir, bu yuzden tag yerine burada kontrol edilir
+	if lang := normalizeLang(cfg.I18n.Lang); lang != "" && !slices.Contains(validation.SupportedLanguages(), lang) {
+		problems = append(problems, fmt.Sprintf("i18n.lang (APP_LANG): %q is not supported, must be one of [%s]",
+			cfg.I18n.Lang, strings.Join(validation.SupportedLanguages(), " ")))
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// normalizeLang: dil kodunu validation.SupportedLanguages ile karsilastirilabilir hale getirir.
+func normalizeLang(lang string) string {
+	return strings.ToLower(strings.TrimSpace(lang))
+}
+
+// describe: "server.port (PORT): must be numeric" seklinde okunabilir bir hata satiri uretir.
+func describe(fe validator.FieldError) string {
+	path, env := configPath(fe.StructNamespace())
+
+	var msg string
+	switch fe.Tag() {
+	case "required":
+		msg = "is required"
+	case "oneof":
+		msg = fmt.Sprintf("must be one of [%s]", fe.Param())
+	case "numeric", "number":
+		msg = "must be numeric"
+	case "min":
+		msg = "must be at least " + fe.Param()
+	case "max":
+		msg = "must be at most " + fe.Param()
+	default:
+		msg = fmt.Sprintf("failed on the %q rule", fe.Tag())
+	}
+
+	if env != "" {
+		return fmt.Sprintf("%s (%s): %s", path, env, msg)
+	}
+	return fmt.Sprintf("%s: %s", path, msg)
+}
+
+// configPath: "Config.Server.Port" -> ("server.port", "PORT") (yaml tag'leri ve env tag'i ile).
+func configPath(structNS string) (string, string) {
+	parts := strings.Split(structNS, ".")
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+
+	t := reflect.TypeOf(Config{})
+	var names []string
+	var env string
+
+	for _, p := range parts {
+		f, ok := t.FieldByName(p)
+		if !ok {
+			names = append(names, strings.ToLower(p))
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "" {
+			name = strings.ToLower(p)
+		}
+		names = append(names, name)
+		env = f.Tag.Get("env")
+		t = f.Type
+	}
+
+	return strings.Join(names, "."), env
+}
+
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, cfg)
+	case ".toml":
+		// TOML once map'e okunur ve YAML uzerinden struct'a yazilir. Boylece ayni (yaml) tag'leri
+		// ve "30s" gibi time.Duration degerleri her iki formatta da ayni sekilde calisir.
+		var m map[string]any
+		if err = toml.Unmarshal(data, &m); err == nil {
+			if data, err = yaml.Marshal(m); err == nil {
+				err = yaml.Unmarshal(data, cfg)
+			}
+		}
+	default:
+		return fmt.Errorf("config file %s: unsupported format, use .yaml, .yml or .toml", path)
+	}
+
+	if err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// registerFlags: flag tag'i olan her alan icin bir string flag tanimlar. Deger, parse'tan sonra setField ile alana yazilir.
+func registerFlags(fs *flag.FlagSet, v reflect.Value) map[string]reflect.Value {
+	out := make(map[string]reflect.Value)
+
+	walkFields(v, func(field reflect.Value, sf reflect.StructField) {
+		name := sf.Tag.Get("flag")
+		if name == "" {
+			return
+		}
+
+		usage := "overrides " + sf.Tag.Get("env")
+		if field.Kind() == reflect.Bool {
+			fs.Bool(name, field.Bool(), usage)
+		} else {
+			fs.String(name, "", usage)
+		}
+		out[name] = field
+	})
+
+	return out
+}
+
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	var err error
+
+	walkFields(v, func(field reflect.Value, sf reflect.StructField) {
+		key := sf.Tag.Get("env")
+		if key == "" || err != nil {
+			return
+		}
+
+		raw, ok := lookup(key)
+		if !ok {
+			return
+		}
+
+		if e := setField(field, strings.TrimSpace(raw)); e != nil {
+			err = fmt.Errorf("env %s: %w", key, e)
+		}
+	})
+
+	return err
+}
+
+// walkFields: ic ice struct'lar dahil tum alanlari gezer.
+func walkFields(v reflect.Value, fn func(reflect.Value, reflect.StructField)) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field, sf := v.Field(i), t.Field(i)
+
+		if sf.Type.Kind() == reflect.Struct && sf.Type != reflect.TypeOf(time.Time{}) {
+			walkFields(field, fn)
+			continue
+		}
+
+		fn(field, sf)
+	}
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// setField: string degeri alanin tipine cevirip yazar.
+func setField(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		// []string, []int: virgulle ayrilmis liste, orn: HTML_ALLOWED_TAGS=p,a,strong, UPLOAD_THUMBNAIL_SIZES=64,256
+		if field.Type().Elem().Kind() == reflect.Slice {
+			return fmt.Errorf("unsupported field type %s", field.Type())
+		}
+		items := reflect.Zero(field.Type())
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if err := setField(elem, item); err != nil {
+				return err
+			}
+			items = reflect.Append(items, elem)
+		}
+		field.Set(items)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	set := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+func countSet(secrets ...Secret) int {
+	n := 0
+	for _, s := range secrets {
+		if s.Value() != "" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,34 @@
+package config
+
+const redacted = "******"
+
+// Secret: sifre, API anahtari gibi degerler icin. Yazdirildiginda, loglandiginda veya
+// JSON/YAML'a cevrildiginde maskelenir. Gercek deger icin Value() kullanilir.
+type Secret string
+
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return `"` + s.String() + `"`
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
+
+func (s Secret) MarshalYAML() (any, error) {
+	return s.String(), nil
+}
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
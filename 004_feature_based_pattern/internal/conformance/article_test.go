@@ -0,0 +1,232 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"feature-base-starter-kit/internal/modules/article"
+	"feature-base-starter-kit/internal/modules/category"
+	"feature-base-starter-kit/internal/modules/user"
+	"feature-base-starter-kit/pkg/database"
+)
+
+func TestArticleRepository(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *database.DB) {
+		repo := article.NewRepository(db)
+		tx := database.NewTxManager(db)
+		ctx := context.Background()
+
+		// Create/Update/Patch birden fazla sorgu calistirir, bkz. article.Repository
+		create := func(a *article.Article) error {
+			return tx.WithinTx(ctx, func(ctx context.Context) error { return repo.Create(ctx, a) })
+		}
+		update := func(a *article.Article) error {
+			return tx.WithinTx(ctx, func(ctx context.Context) error { return repo.Update(ctx, a) })
+		}
+		patch := func(id, version int64, changes map[string]any) (*article.Article, error) {
+			var a *article.Article
+			err := tx.WithinTx(ctx, func(ctx context.Context) error {
+				var err error
+				a, err = repo.Patch(ctx, id, version, changes)
+				return err
+			})
+			return a, err
+		}
+
+		author := &user.User{Name: "Article Author", Email: "author@example.com", Age: 30, IsActive: true}
+		if err := user.NewRepository(db).Create(ctx, author); err != nil {
+			t.Fatalf("create author: %v", err)
+		}
+		categories := category.NewRepository(db)
+		news := &category.Category{Name: "News", Slug: "news", IsActive: true}
+		sports := &category.Category{Name: "Sports", Slug: "sports", IsActive: true}
+		for _, c := range []*category.Category{news, sports} {
+			if err := categories.Create(ctx, c); err != nil {
+				t.Fatalf("create category: %v", err)
+			}
+		}
+
+		t.Run("CRUD", func(t *testing.T) {
+			a := newArticle("hello-world")
+			a.UserID = &author.ID
+			a.SEOSettings = &article.SEOSettings{MetaTitle: "Hello", Keywords: []string{"go"}}
+			a.CategoryIDs = []int64{news.ID}
+			a.Tags = []string{"Go", "Databases"}
+			if err := create(a); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			if a.ID == 0 || a.Version != 1 || a.Status != article.StatusDraft {
+				t.Fatalf("Create did not reload the record: %+v", a)
+			}
+
+			got, err := repo.GetByID(ctx, a.ID, false)
+			if err != nil {
+				t.Fatalf("GetByID: %v", err)
+			}
+			if got.Slug != a.Slug || got.UserID == nil || *got.UserID != author.ID {
+				t.Fatalf("GetByID = %+v, want slug %s and user %d", got, a.Slug, author.ID)
+			}
+			if got.SEOSettings == nil || got.SEOSettings.MetaTitle != "Hello" || !slices.Equal(got.SEOSettings.Keywords, []string{"go"}) {
+				t.Errorf("seo_settings = %+v", got.SEOSettings)
+			}
+			if !slices.Equal(got.CategoryIDs, []int64{news.ID}) {
+				t.Errorf("category_ids = %v, want [%d]", got.CategoryIDs, news.ID)
+			}
+			if !slices.Equal(got.Tags, []string{"Databases", "Go"}) {
+				t.Errorf("tags = %v, want [Databases Go]", got.Tags)
+			}
+
+			got.Title = "Hello again"
+			got.CategoryIDs = []int64{sports.ID}
+			got.Tags = []string{"Go"}
+			if err := update(got); err != nil {
+				t.Fatalf("Update: %v", err)
+			}
+			if got.Title != "Hello again" || got.Version != 2 || !slices.Equal(got.CategoryIDs, []int64{sports.ID}) || !slices.Equal(got.Tags, []string{"Go"}) {
+				t.Fatalf("Update = %+v", got)
+			}
+
+			stale := *got
+			stale.Version = 1
+			if err := update(&stale); !errors.Is(err, database.ErrVersionMismatch) {
+				t.Fatalf("Update with stale version: err = %v, want ErrVersionMismatch", err)
+			}
+
+			patched, err := patch(a.ID, got.Version, map[string]any{"is_active": false, "tags": []string{}})
+			if err != nil {
+				t.Fatalf("Patch: %v", err)
+			}
+			if patched.IsActive || len(patched.Tags) != 0 || patched.Version != 3 {
+				t.Fatalf("Patch = %+v, want inactive, no tags and version 3", patched)
+			}
+
+			if err := repo.Delete(ctx, a.ID, patched.Version); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := repo.GetByID(ctx, a.ID, false); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID after Delete: err = %v, want ErrNotFound", err)
+			}
+			restored, err := repo.Restore(ctx, a.ID, patched.Version+1)
+			if err != nil {
+				t.Fatalf("Restore: %v", err)
+			}
+			if restored.DeletedAt != nil || !slices.Equal(restored.CategoryIDs, []int64{sports.ID}) {
+				t.Fatalf("Restore = %+v, want live record with its categories", restored)
+			}
+
+			if _, err := repo.GetByID(ctx, a.ID+1000, false); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID of a missing id: err = %v, want ErrNotFound", err)
+			}
+		})
+
+		t.Run("UniqueViolation", func(t *testing.T) {
+			taken := newArticle("taken-slug")
+			other := newArticle("other-slug")
+			for _, a := range []*article.Article{taken, other} {
+				if err := create(a); err != nil {
+					t.Fatalf("Create %s: %v", a.Slug, err)
+				}
+			}
+
+			missing := author.ID + 1000
+			tests := []struct {
+				name string
+				run  func() error
+				want error
+			}{
+				{"Create", func() error { return create(newArticle(taken.Slug)) }, article.ErrSlugTaken},
+				{"Update", func() error {
+					a := *other
+					a.Slug = taken.Slug
+					return update(&a)
+				}, article.ErrSlugTaken},
+				{"Patch", func() error {
+					_, err := patch(other.ID, 0, map[string]any{"slug": taken.Slug})
+					return err
+				}, article.ErrSlugTaken},
+				{"missing user", func() error {
+					a := newArticle("missing-user")
+					a.UserID = &missing
+					return create(a)
+				}, article.ErrInvalidReference},
+				{"missing category", func() error {
+					a := newArticle("missing-category")
+					a.CategoryIDs = []int64{news.ID + sports.ID + 1000}
+					return create(a)
+				}, article.ErrInvalidReference},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					if err := tt.run(); !errors.Is(err, tt.want) {
+						t.Fatalf("err = %v, want %v", err, tt.want)
+					}
+				})
+			}
+		})
+
+		t.Run("Pagination", func(t *testing.T) {
+			if _, err := db.ExecContext(ctx, "DELETE FROM articles"); err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int64
+			for i := range 5 {
+				a := newArticle(fmt.Sprintf("page-%d", i))
+				if i%2 == 0 {
+					a.CategoryIDs = []int64{news.ID}
+				}
+				if err := create(a); err != nil {
+					t.Fatalf("Create: %v", err)
+				}
+				ids = append(ids, a.ID)
+			}
+			if err := repo.Delete(ctx, ids[3], 0); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+
+			tests := []struct {
+				name      string
+				opts      article.ListOptions
+				wantIDs   []int64
+				wantTotal int
+			}{
+				{"first page", article.ListOptions{Limit: 2}, ids[0:2], 4},
+				{"second page", article.ListOptions{Limit: 2, Offset: 2}, []int64{ids[2], ids[4]}, 4},
+				{"past the end", article.ListOptions{Limit: 2, Offset: 4}, nil, 4},
+				{"with deleted", article.ListOptions{Limit: 10, Offset: 3, WithDeleted: true}, ids[3:5], 5},
+				{"category", article.ListOptions{Limit: 2, Offset: 1, CategoryID: news.ID}, []int64{ids[2], ids[4]}, 3},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					articles, total, err := repo.List(ctx, tt.opts)
+					if err != nil {
+						t.Fatalf("List: %v", err)
+					}
+					if total != tt.wantTotal {
+						t.Errorf("total = %d, want %d", total, tt.wantTotal)
+					}
+					var got []int64
+					for _, a := range articles {
+						got = append(got, a.ID)
+					}
+					if !equalIDs(got, tt.wantIDs) {
+						t.Errorf("ids = %v, want %v", got, tt.wantIDs)
+					}
+				})
+			}
+		})
+	})
+}
+
+func newArticle(slug string) *article.Article {
+	return &article.Article{
+		Title:             "Title " + slug,
+		Slug:              slug,
+		Description:       "<p>" + slug + "</p>",
+		DescriptionFormat: article.FormatHTML,
+		IsActive:          true,
+	}
+}
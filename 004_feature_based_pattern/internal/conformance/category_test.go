@@ -0,0 +1,160 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"feature-base-starter-kit/internal/modules/category"
+	"feature-base-starter-kit/pkg/database"
+)
+
+func TestCategoryRepository(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *database.DB) {
+		repo := category.NewRepository(db)
+		ctx := context.Background()
+
+		t.Run("CRUD", func(t *testing.T) {
+			parent := &category.Category{Name: "Technology", Slug: "technology", IsActive: true}
+			if err := repo.Create(ctx, parent); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			child := &category.Category{Name: "Go", Slug: "go", ParentID: &parent.ID, IsActive: true}
+			if err := repo.Create(ctx, child); err != nil {
+				t.Fatalf("Create child: %v", err)
+			}
+			if child.ID == 0 || child.CreatedAt.IsZero() {
+				t.Fatalf("Create did not reload the record: %+v", child)
+			}
+
+			got, err := repo.GetByID(ctx, child.ID)
+			if err != nil {
+				t.Fatalf("GetByID: %v", err)
+			}
+			if got.Slug != "go" || got.ParentID == nil || *got.ParentID != parent.ID {
+				t.Fatalf("GetByID = %+v, want slug go under %d", got, parent.ID)
+			}
+
+			got.Name = "Golang"
+			got.IsActive = false
+			if err := repo.Update(ctx, got); err != nil {
+				t.Fatalf("Update: %v", err)
+			}
+			if got.Name != "Golang" || got.IsActive {
+				t.Fatalf("Update = %+v", got)
+			}
+
+			upserted := &category.Category{Name: "Go Language", Slug: "go", IsActive: true}
+			if err := repo.Upsert(ctx, upserted); err != nil {
+				t.Fatalf("Upsert: %v", err)
+			}
+			if upserted.ID != child.ID || upserted.Name != "Go Language" {
+				t.Fatalf("Upsert = %+v, want existing record %d updated", upserted, child.ID)
+			}
+
+			// alt kategoriler koke tasinir
+			if err := repo.Delete(ctx, parent.ID); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := repo.GetByID(ctx, parent.ID); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID after Delete: err = %v, want ErrNotFound", err)
+			}
+			orphan, err := repo.GetByID(ctx, child.ID)
+			if err != nil || orphan.ParentID != nil {
+				t.Fatalf("child after parent Delete = %+v, %v, want root category", orphan, err)
+			}
+			if err := repo.Delete(ctx, parent.ID); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("Delete of a missing id: err = %v, want ErrNotFound", err)
+			}
+		})
+
+		t.Run("UniqueViolation", func(t *testing.T) {
+			taken := &category.Category{Name: "Taken", Slug: "taken", IsActive: true}
+			other := &category.Category{Name: "Other", Slug: "other", IsActive: true}
+			for _, c := range []*category.Category{taken, other} {
+				if err := repo.Create(ctx, c); err != nil {
+					t.Fatalf("Create %s: %v", c.Slug, err)
+				}
+			}
+			child := &category.Category{Name: "Child", Slug: "child", ParentID: &other.ID, IsActive: true}
+			if err := repo.Create(ctx, child); err != nil {
+				t.Fatalf("Create child: %v", err)
+			}
+
+			missing := taken.ID + other.ID + child.ID + 1000
+			tests := []struct {
+				name string
+				run  func() error
+				want error
+			}{
+				{"Create", func() error {
+					return repo.Create(ctx, &category.Category{Name: "Again", Slug: taken.Slug})
+				}, category.ErrSlugTaken},
+				{"Update", func() error {
+					c := *other
+					c.Slug = taken.Slug
+					return repo.Update(ctx, &c)
+				}, category.ErrSlugTaken},
+				{"missing parent", func() error {
+					return repo.Create(ctx, &category.Category{Name: "Lost", Slug: "lost", ParentID: &missing})
+				}, category.ErrInvalidParent},
+				{"cycle", func() error {
+					c := *other
+					c.ParentID = &child.ID
+					return repo.Update(ctx, &c)
+				}, category.ErrParentCycle},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					if err := tt.run(); !errors.Is(err, tt.want) {
+						t.Fatalf("err = %v, want %v", err, tt.want)
+					}
+				})
+			}
+		})
+
+		t.Run("Pagination", func(t *testing.T) {
+			if _, err := db.ExecContext(ctx, "DELETE FROM categories"); err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int64
+			for i := range 5 {
+				c := &category.Category{Name: fmt.Sprintf("Category %d", i), Slug: fmt.Sprintf("category-%d", i), IsActive: true}
+				if err := repo.Create(ctx, c); err != nil {
+					t.Fatalf("Create: %v", err)
+				}
+				ids = append(ids, c.ID)
+			}
+
+			tests := []struct {
+				name          string
+				limit, offset int
+				wantIDs       []int64
+			}{
+				{"first page", 2, 0, ids[0:2]},
+				{"last page", 2, 4, ids[4:5]},
+				{"past the end", 2, 6, nil},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					categories, total, err := repo.List(ctx, tt.limit, tt.offset)
+					if err != nil {
+						t.Fatalf("List: %v", err)
+					}
+					if total != len(ids) {
+						t.Errorf("total = %d, want %d", total, len(ids))
+					}
+					var got []int64
+					for _, c := range categories {
+						got = append(got, c.ID)
+					}
+					if !equalIDs(got, tt.wantIDs) {
+						t.Errorf("ids = %v, want %v", got, tt.wantIDs)
+					}
+				})
+			}
+		})
+	})
+}
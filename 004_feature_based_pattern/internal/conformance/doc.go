@@ -0,0 +1,6 @@
+// Package conformance: user, article ve category repository'lerinin her dialect'te ayni davrandigini dogrulayan
+// ortak test paketi (CRUD, unique ihlallerinin modul hatalarina cevrilmesi, sayfalama). Testler tek pakette durur,
+// boylece ayni harici veritabanini kullanan testler paralel calismaz. sqlite (bellekte) her zaman, postgres ve mysql
+// TEST_POSTGRES_DSN / TEST_MYSQL_DSN verilirse calisir (bkz. suite_test.go). Yerelde "make test-db" bu veritabanlarini
+// docker ile baslatip testleri calistirir; CI'da .github/workflows/conformance.yml servis olarak baslatir.
+package conformance
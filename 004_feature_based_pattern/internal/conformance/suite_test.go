@@ -0,0 +1,66 @@
+package conformance
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"feature-base-starter-kit/migrations"
+	"feature-base-starter-kit/pkg/database"
+)
+
+// dialects: testlerin kostugu veritabanlari. Harici veritabanlari DSN ortam degiskeni verilmezse atlanir; tablolar
+// her testten once bosaltildigi icin bu veritabanlari sadece testlere ayrilmis olmalidir.
+// mysql DSN'i parseTime=true icermelidir, orn: user:pass@tcp(localhost:3306)/test?parseTime=true
+var dialects = []struct {
+	driver string
+	env    string // DSN ortam degiskeni, bos ise harici servis gerekmez
+	name   string
+}{
+	{driver: "postgres", env: "TEST_POSTGRES_DSN"},
+	{driver: "mysql", env: "TEST_MYSQL_DSN"},
+	// bellekteki veritabani her testte bos olarak acilir (bkz. database.Open), CI'da servis olmadan calisir
+	{driver: "sqlite", name: ":memory:"},
+}
+
+// tables: bosaltma sirasi; article_categories, article_tags, article_status_history ON DELETE CASCADE ile silinir.
+var tables = []string{"uploads", "comments", "articles", "tags", "categories", "users"}
+
+// forEachDialect: fn'i her dialect icin alt test olarak, migration'lari calismis bos bir veritabaniyla calistirir.
+func forEachDialect(t *testing.T, fn func(t *testing.T, db *database.DB)) {
+	for _, d := range dialects {
+		t.Run(d.driver, func(t *testing.T) {
+			cfg := database.Config{Driver: d.driver, Name: d.name}
+			if d.env != "" {
+				if cfg.DSN = os.Getenv(d.env); cfg.DSN == "" {
+					t.Skipf("%s is not set, see make test-db", d.env)
+				}
+			}
+			fn(t, open(t, cfg))
+		})
+	}
+}
+
+func open(t *testing.T, cfg database.Config) *database.DB {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	db, err := database.Open(ctx, cfg)
+	if err != nil {
+		t.Fatalf("open %s: %v", cfg.Driver, err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := database.Migrate(ctx, db, migrations.FS); err != nil {
+		t.Fatalf("migrate %s: %v", cfg.Driver, err)
+	}
+	for _, table := range tables {
+		if _, err := db.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			t.Fatalf("reset %s: %v", table, err)
+		}
+	}
+	return db
+}
@@ -0,0 +1,219 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"feature-base-starter-kit/internal/modules/user"
+	"feature-base-starter-kit/pkg/database"
+)
+
+func TestUserRepository(t *testing.T) {
+	forEachDialect(t, func(t *testing.T, db *database.DB) {
+		repo := user.NewRepository(db)
+		ctx := context.Background()
+
+		t.Run("CRUD", func(t *testing.T) {
+			u := &user.User{Name: "Ada Lovelace", Email: "ada@example.com", Age: 36, IsActive: true}
+			if err := repo.Create(ctx, u); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			if u.ID == 0 || u.Version != 1 || u.CreatedAt.IsZero() {
+				t.Fatalf("Create did not reload the record: %+v", u)
+			}
+
+			got, err := repo.GetByID(ctx, u.ID, false)
+			if err != nil {
+				t.Fatalf("GetByID: %v", err)
+			}
+			if got.Name != u.Name || got.Email != u.Email || got.Age != u.Age || !got.IsActive {
+				t.Fatalf("GetByID = %+v, want %+v", got, u)
+			}
+
+			u.Name = "Ada King"
+			if err := repo.Update(ctx, u); err != nil {
+				t.Fatalf("Update: %v", err)
+			}
+			if u.Name != "Ada King" || u.Version != 2 {
+				t.Fatalf("Update = %+v, want name Ada King and version 2", u)
+			}
+
+			stale := *u
+			stale.Version = 1
+			if err := repo.Update(ctx, &stale); !errors.Is(err, database.ErrVersionMismatch) {
+				t.Fatalf("Update with stale version: err = %v, want ErrVersionMismatch", err)
+			}
+
+			patched, err := repo.Patch(ctx, u.ID, u.Version, map[string]any{"age": 37})
+			if err != nil {
+				t.Fatalf("Patch: %v", err)
+			}
+			if patched.Age != 37 || patched.Name != "Ada King" || patched.Version != 3 {
+				t.Fatalf("Patch = %+v, want age 37 and version 3", patched)
+			}
+
+			if err := repo.Delete(ctx, u.ID, patched.Version); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := repo.GetByID(ctx, u.ID, false); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID after Delete: err = %v, want ErrNotFound", err)
+			}
+			deleted, err := repo.GetByID(ctx, u.ID, true)
+			if err != nil || deleted.DeletedAt == nil {
+				t.Fatalf("GetByID(withDeleted) after Delete = %+v, %v", deleted, err)
+			}
+
+			if _, err := repo.Restore(ctx, u.ID, patched.Version); !errors.Is(err, database.ErrVersionMismatch) {
+				t.Fatalf("Restore with stale version: err = %v, want ErrVersionMismatch", err)
+			}
+			restored, err := repo.Restore(ctx, u.ID, deleted.Version)
+			if err != nil {
+				t.Fatalf("Restore: %v", err)
+			}
+			if restored.DeletedAt != nil {
+				t.Fatalf("Restore left deleted_at = %v", restored.DeletedAt)
+			}
+			if _, err := repo.Restore(ctx, u.ID, 0); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("Restore of a live record: err = %v, want ErrNotFound", err)
+			}
+
+			if _, err := repo.GetByID(ctx, u.ID+1000, false); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID of a missing id: err = %v, want ErrNotFound", err)
+			}
+			if err := repo.Delete(ctx, u.ID+1000, 0); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("Delete of a missing id: err = %v, want ErrNotFound", err)
+			}
+		})
+
+		t.Run("UniqueViolation", func(t *testing.T) {
+			taken := &user.User{Name: "Grace Hopper", Email: "grace@example.com", Age: 85, IsActive: true}
+			other := &user.User{Name: "Alan Turing", Email: "alan@example.com", Age: 41, IsActive: true}
+			for _, u := range []*user.User{taken, other} {
+				if err := repo.Create(ctx, u); err != nil {
+					t.Fatalf("Create %s: %v", u.Email, err)
+				}
+			}
+
+			tests := []struct {
+				name string
+				run  func() error
+			}{
+				{"Create", func() error {
+					return repo.Create(ctx, &user.User{Name: "Someone Else", Email: taken.Email, Age: 20})
+				}},
+				{"CreateBatch", func() error {
+					return repo.CreateBatch(ctx, []user.User{{Name: "Someone Else", Email: taken.Email, Age: 20}})
+				}},
+				{"Update", func() error {
+					u := *other
+					u.Email = taken.Email
+					return repo.Update(ctx, &u)
+				}},
+				{"Patch", func() error {
+					_, err := repo.Patch(ctx, other.ID, 0, map[string]any{"email": taken.Email})
+					return err
+				}},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					if err := tt.run(); !errors.Is(err, user.ErrEmailTaken) {
+						t.Fatalf("err = %v, want ErrEmailTaken", err)
+					}
+				})
+			}
+
+			// soft delete edilen kullanicinin email'i purge edilene kadar kullanimda sayilir
+			if err := repo.Delete(ctx, taken.ID, 0); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if err := repo.Create(ctx, &user.User{Name: "Someone Else", Email: taken.Email, Age: 20}); !errors.Is(err, user.ErrEmailTaken) {
+				t.Fatalf("Create with a soft deleted email: err = %v, want ErrEmailTaken", err)
+			}
+		})
+
+		t.Run("Pagination", func(t *testing.T) {
+			if _, err := db.ExecContext(ctx, "DELETE FROM users"); err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int64
+			for i := range 5 {
+				u := &user.User{Name: fmt.Sprintf("User %d", i), Email: fmt.Sprintf("user%d@example.com", i), Age: 20 + i}
+				if err := repo.Create(ctx, u); err != nil {
+					t.Fatalf("Create: %v", err)
+				}
+				ids = append(ids, u.ID)
+			}
+			if err := repo.Delete(ctx, ids[4], 0); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+
+			tests := []struct {
+				name      string
+				opts      user.ListOptions
+				wantIDs   []int64
+				wantTotal int
+			}{
+				{"first page", user.ListOptions{Limit: 2}, ids[0:2], 4},
+				{"second page", user.ListOptions{Limit: 2, Offset: 2}, ids[2:4], 4},
+				{"past the end", user.ListOptions{Limit: 2, Offset: 4}, nil, 4},
+				{"with deleted", user.ListOptions{Limit: 2, Offset: 4, WithDeleted: true}, ids[4:5], 5},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					users, total, err := repo.List(ctx, tt.opts)
+					if err != nil {
+						t.Fatalf("List: %v", err)
+					}
+					if total != tt.wantTotal {
+						t.Errorf("total = %d, want %d", total, tt.wantTotal)
+					}
+					if got := userIDs(users); !equalIDs(got, tt.wantIDs) {
+						t.Errorf("ids = %v, want %v", got, tt.wantIDs)
+					}
+				})
+			}
+		})
+
+		t.Run("Purge", func(t *testing.T) {
+			u := &user.User{Name: "Purged User", Email: "purged@example.com", Age: 30}
+			if err := repo.Create(ctx, u); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			if err := repo.Delete(ctx, u.ID, 0); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+
+			if _, _, err := repo.Purge(ctx, time.Now().Add(time.Minute)); err != nil {
+				t.Fatalf("Purge: %v", err)
+			}
+			if _, err := repo.GetByID(ctx, u.ID, true); !errors.Is(err, database.ErrNotFound) {
+				t.Fatalf("GetByID after Purge: err = %v, want ErrNotFound", err)
+			}
+		})
+	})
+}
+
+func userIDs(users []user.User) []int64 {
+	var ids []int64
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+// equalIDs: nil ve bos slice esit sayilir.
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,24 +2,40 @@ package middleware
 
 import (
 	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/auth"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware(validSecretKey string) gin.HandlerFunc {
+// AuthMiddleware: X-API-KEY header'ini keys icinde arar ve anahtarin rolunu context'e koyar (bkz. auth.FromContext).
+func AuthMiddleware(keys map[string]auth.Role) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		apiKey := ctx.GetHeader("X-API-KEY")
 
-		if apiKey != validSecretKey {
+		role, ok := keys[apiKey]
+		if apiKey == "" || !ok {
 			// Abort : Request zincirini durdurur ve belirtilen yanıtı gönderir.
 			// Yalnizca return vermek, zinciri durdurmaz. Bu nedenle Abort kullanilir.
 
-			api.SendError(ctx, http.StatusUnauthorized, "Unauthorized access", nil)
+			api.SendError(ctx, http.StatusUnauthorized, "auth.unauthorized", nil)
 			ctx.Abort()
 			return
 		}
 
+		auth.Set(ctx, role)
+		ctx.Next()
+	}
+}
+
+// RequireRole: AuthMiddleware'den sonra kullanilir; istegin rolu min'den az yetkiliyse 403 gonderir (bkz. auth.Role.Allows).
+func RequireRole(min auth.Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !auth.FromContext(ctx).Allows(min) {
+			api.SendError(ctx, http.StatusForbidden, "auth.forbidden", nil)
+			ctx.Abort()
+			return
+		}
 		ctx.Next()
 	}
 }
@@ -0,0 +1,61 @@
+package article
+
+import (
+	"feature-base-starter-kit/pkg/api"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportColumns: disa aktarilan kolonlar, sirasi exportRow ile ayni olmalidir. Kategori ve etiketler ayri tablolarda
+// oldugu icin cursor acikken okunmaz; gerekirse GET /articles ile alinabilir.
+var exportColumns = []string{
+	"id", "title", "slug", "status", "is_active", "user_id", "short_description", "description_format", "description",
+	"description_source", "seo_settings", "published_at", "version", "created_at", "updated_at", "deleted_at",
+}
+
+// ExportArticlesHandler: GET /articles/export, GET /articles ile ayni filtreler (?category_id=&include_descendants=true&tag=
+// &with_deleted=true). Format Accept header'iyla secilir: text/csv (varsayilan), application/x-ndjson veya XLSX.
+// Kayitlar bellege alinmadan veritabani cursor'undan yazilir.
+func (h *Handler) ExportArticlesHandler(c *gin.Context) {
+	var req ListArticlesRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+	opts := ListOptions{
+		CategoryID:         req.CategoryID,
+		IncludeDescendants: req.IncludeDescendants,
+		Tag:                req.Tag,
+		WithDeleted:        api.QueryBool(c, "with_deleted"),
+	}
+
+	api.Export(c, "articles", exportColumns, func(write api.RowFunc) error {
+		return h.repo.Export(c.Request.Context(), opts, func(a *Article) error {
+			return write(exportRow(a))
+		})
+	})
+}
+
+// exportRow: nil isaretciler bos hucre (NDJSON'da null) olarak yazilir.
+func exportRow(a *Article) []any {
+	row := []any{a.ID, a.Title, a.Slug, string(a.Status), a.IsActive, nil, nil, string(a.DescriptionFormat), a.Description,
+		nil, nil, nil, a.Version, a.CreatedAt, a.UpdatedAt, nil}
+	if a.UserID != nil {
+		row[5] = *a.UserID
+	}
+	if a.ShortDescription != nil {
+		row[6] = *a.ShortDescription
+	}
+	if a.DescriptionSource != nil {
+		row[9] = *a.DescriptionSource
+	}
+	if a.SEOSettings != nil {
+		row[10] = a.SEOSettings
+	}
+	if a.PublishedAt != nil {
+		row[11] = *a.PublishedAt
+	}
+	if a.DeletedAt != nil {
+		row[15] = *a.DeletedAt
+	}
+	return row
+}
@@ -0,0 +1,83 @@
+package article
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"feature-base-starter-kit/pkg/markdown"
+)
+
+// Format: istekte description'in hangi formatta yazildigi. Veritabaninda description her zaman HTML'dir.
+type Format string
+
+const (
+	FormatHTML     Format = "html"
+	FormatMarkdown Format = "markdown" // description_source'ta saklanir, description'a render edilmis HTML yazilir
+)
+
+// TOC: markdown basliklarindan uretilen icindekiler (toc kolonu, JSON).
+type TOC []markdown.Heading
+
+// Value: bos liste NULL yazilir; JSON string olarak yazilir (bkz. SEOSettings.Value).
+func (t TOC) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (t *TOC) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, t)
+	case string:
+		return json.Unmarshal([]byte(v), t)
+	}
+	return fmt.Errorf("article: cannot scan %T into TOC", src)
+}
+
+// Body: description'in veritabanina yazilan hali (description, description_format, description_source, toc kolonlari).
+type Body struct {
+	Format Format
+	HTML   string  // sanitize edilmis HTML
+	Source *string // markdown kaynagi, html formatinda nil
+	TOC    TOC
+}
+
+// bodyOf: kaydin mevcut description'i.
+func bodyOf(a *Article) Body {
+	return Body{Format: a.DescriptionFormat, HTML: a.Description, Source: a.DescriptionSource, TOC: a.TOC}
+}
+
+// source: istemcinin duzenledigi metin (markdown kaynagi veya HTML).
+func (b Body) source() string {
+	if b.Source != nil {
+		return *b.Source
+	}
+	return b.HTML
+}
+
+func (a *Article) setBody(b Body) {
+	a.DescriptionFormat, a.Description, a.DescriptionSource, a.TOC = b.Format, b.HTML, b.Source, b.TOC
+}
+
+// render: istekteki metni format'a gore HTML'e cevirir (sanitize edilmemis). html formatinda metin aynen doner.
+func render(format Format, text string) (Body, error) {
+	if format != FormatMarkdown {
+		return Body{Format: FormatHTML, HTML: text}, nil
+	}
+
+	html, toc, err := markdown.Render([]byte(text))
+	if err != nil {
+		return Body{}, err
+	}
+	return Body{Format: FormatMarkdown, HTML: html, Source: &text, TOC: toc}, nil
+}
@@ -0,0 +1,423 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/auth"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/validation"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler: article endpoint'leri. Makale, article_categories ve article_tags satirlari tek transaction'da yazilir.
+// description her yazmada (markdown ise HTML'e render edildikten sonra) sanitizer'dan gecer.
+type Handler struct {
+	repo      Repository
+	tx        *database.TxManager
+	sanitizer *Sanitizer
+}
+
+func NewHandler(repo Repository, tx *database.TxManager, sanitizer *Sanitizer) *Handler {
+	return &Handler{repo: repo, tx: tx, sanitizer: sanitizer}
+}
+
+func (h *Handler) CreateArticleHandler(c *gin.Context) {
+	var req CreateArticleRequest
+	if !api.BindJSON(c, &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+	if err := checkActive(true, req.IsActive, auth.FromContext(c)); err != nil {
+		api.SendError(c, http.StatusForbidden, "auth.forbidden", nil)
+		return
+	}
+	body, ok := h.description(c, req.DescriptionFormat, req.Description)
+	if !ok {
+		return
+	}
+	h.fillExcerpt(body.HTML, &req.ShortDescription)
+
+	a := &Article{
+		Title:            req.Title,
+		Slug:             req.Slug,
+		ShortDescription: req.ShortDescription,
+		IsActive:         req.IsActive == nil || *req.IsActive,
+		UserID:           req.UserID,
+		SEOSettings:      req.SEOSettings.settings(),
+		CategoryIDs:      req.CategoryIDs,
+		Tags:             req.Tags,
+	}
+	a.setBody(body)
+	err := h.tx.WithinTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.repo.Create(ctx, a)
+	})
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusCreated, "article.created", a)
+}
+
+func (h *Handler) GetArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	// ?with_deleted=true: silinmis kayitlar da gosterilir
+	a, err := h.repo.GetByID(c.Request.Context(), id, api.QueryBool(c, "with_deleted"))
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", a)
+}
+
+// ListArticlesHandler: ?category_id= ile kategoriye gore filtreler, ?include_descendants=true ile alt kategorilerdeki
+// makaleler de listelenir. ?tag= ile etiket slug'ina gore filtrelenir.
+func (h *Handler) ListArticlesHandler(c *gin.Context) {
+	var req ListArticlesRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+
+	h.list(c, ListOptions{CategoryID: req.CategoryID, IncludeDescendants: req.IncludeDescendants, Tag: req.Tag})
+}
+
+// ArticlesByTagHandler: GET /tags/:slug/articles, etiketteki makaleler. Etiket yoksa bos sayfa doner.
+func (h *Handler) ArticlesByTagHandler(c *gin.Context) {
+	h.list(c, ListOptions{Tag: c.Param("slug")})
+}
+
+// list: opts'a sayfalama ve ?with_deleted ekleyerek makaleleri listeler.
+func (h *Handler) list(c *gin.Context, opts ListOptions) {
+	p := api.PaginationFrom(c)
+	opts.Limit, opts.Offset = p.Limit(), p.Offset()
+	opts.WithDeleted = api.QueryBool(c, "with_deleted")
+
+	articles, total, err := h.repo.List(c.Request.Context(), opts)
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", api.NewPage(articles, p, total))
+}
+
+// SearchArticlesHandler: ?q= ile tam metin aramasi. Turkce isteklerde (?lang=tr, Accept-Language) kok bulma kullanilir.
+func (h *Handler) SearchArticlesHandler(c *gin.Context) {
+	var req SearchArticlesRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+
+	p := api.PaginationFrom(c)
+	results, total, err := h.repo.Search(c.Request.Context(), SearchOptions{
+		Query:  req.Q,
+		Lang:   i18n.FromContext(c).Lang(),
+		Limit:  p.Limit(),
+		Offset: p.Offset(),
+	})
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", api.NewPage(results, p, total))
+}
+
+func (h *Handler) UpdateArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
+	}
+
+	var req UpdateArticleRequest
+	if !api.BindJSON(c, &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+	if !allowEdit(c, current, req.IsActive) {
+		return
+	}
+	body, ok := h.description(c, req.DescriptionFormat, req.Description)
+	if !ok {
+		return
+	}
+	h.fillExcerpt(body.HTML, &req.ShortDescription)
+
+	a := &Article{
+		ID:               id,
+		Title:            req.Title,
+		Slug:             req.Slug,
+		ShortDescription: req.ShortDescription,
+		IsActive:         current.IsActive,
+		UserID:           req.UserID,
+		SEOSettings:      req.SEOSettings.settings(),
+		CategoryIDs:      req.CategoryIDs,
+		Tags:             req.Tags,
+		Version:          current.Version,
+	}
+	if req.IsActive != nil {
+		a.IsActive = *req.IsActive
+	}
+	a.setBody(body)
+	err := h.tx.WithinTx(c.Request.Context(), func(ctx context.Context) error {
+		return h.repo.Update(ctx, a)
+	})
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "article.updated", a)
+}
+
+// PatchArticleHandler: kaydi merge patch veya JSON patch ile kismen gunceller (bkz. user.Handler.PatchUserHandler).
+func (h *Handler) PatchArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
+	}
+
+	var req UpdateArticleRequest
+	if !api.BindPatch(c, updateRequestFrom(current), &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+	if !allowEdit(c, current, req.IsActive) {
+		return
+	}
+
+	// degismeyen description tekrar render edilmez/temizlenmez (politika sonradan degismis olsa bile patch reddedilmez)
+	body := bodyOf(current)
+	if req.DescriptionFormat != body.Format || req.Description != body.source() {
+		if body, ok = h.description(c, req.DescriptionFormat, req.Description); !ok {
+			return
+		}
+	}
+	h.fillExcerpt(body.HTML, &req.ShortDescription)
+
+	var a *Article
+	err := h.tx.WithinTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		a, err = h.repo.Patch(ctx, id, current.Version, req.changes(current, body))
+		return err
+	})
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "article.updated", a)
+}
+
+func (h *Handler) DeleteArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok || !allowEdit(c, current, nil) {
+		return
+	}
+
+	if err := h.repo.Delete(c.Request.Context(), id, current.Version); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "article.deleted", nil)
+}
+
+// RestoreArticleHandler: soft delete edilmis kaydi geri alir. Diger yazma islemleri gibi If-Match zorunludur.
+func (h *Handler) RestoreArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, err := h.repo.GetByID(c.Request.Context(), id, true)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+	if current.DeletedAt == nil {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, id)
+		return
+	}
+	if !api.CheckIfMatch(c, current) || !allowEdit(c, current, nil) {
+		return
+	}
+
+	a, err := h.repo.Restore(c.Request.Context(), id, current.Version)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "article.restored", a)
+}
+
+// TransitionArticleHandler: yayin akisinda durum degisikligi (orn: draft -> review, review -> scheduled).
+// Gecis kurallari ve rol kontrolleri workflow.go'dadir; If-Match zorunludur.
+func (h *Handler) TransitionArticleHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
+	}
+
+	var req TransitionArticleRequest
+	if !api.BindJSON(c, &req) {
+		return
+	}
+
+	t, err := newTransition(current, req.Status, req.PublishAt, auth.FromContext(c), req.Note, time.Now().UTC())
+	if err != nil {
+		sendTransitionError(c, err, current.Status, req.Status)
+		return
+	}
+
+	var a *Article
+	err = h.tx.WithinTx(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		a, err = h.repo.Transition(ctx, id, current.Version, t)
+		return err
+	})
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "article.status_changed", a, a.Status)
+}
+
+// ArticleHistoryHandler: makalenin durum gecisleri (silinmis makaleler dahil).
+func (h *Handler) ArticleHistoryHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	if _, err := h.repo.GetByID(c.Request.Context(), id, true); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	history, err := h.repo.History(c.Request.Context(), id)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", history)
+}
+
+// loadForWrite: guncelleme/silme oncesi kaydi okur ve If-Match header'ini kontrol eder (bkz. user.Handler).
+func (h *Handler) loadForWrite(c *gin.Context, id int64) (*Article, bool) {
+	current, err := h.repo.GetByID(c.Request.Context(), id, false)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return nil, false
+	}
+
+	return current, api.CheckIfMatch(c, current)
+}
+
+// allowEdit: checkEdit'i istegin rolu ile calistirir; yetki yoksa 403 gonderir ve false doner.
+func allowEdit(c *gin.Context, a *Article, isActive *bool) bool {
+	if err := checkEdit(a, isActive, auth.FromContext(c)); err != nil {
+		api.SendError(c, http.StatusForbidden, "auth.forbidden", nil)
+		return false
+	}
+	return true
+}
+
+// description: istekteki description'i format'a gore HTML'e cevirir ve allow-list'e gore temizler; reject modunda
+// politikaya uymayan HTML varsa alan hatasi (422) gonderir ve false doner.
+func (h *Handler) description(c *gin.Context, format Format, text string) (Body, bool) {
+	body, err := render(format, text)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return Body{}, false
+	}
+
+	if h.sanitizer.Reject() {
+		if violation := h.sanitizer.Check(body.HTML, body.Format); violation != "" {
+			loc := i18n.FromContext(c)
+			api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+				validation.Path("description"): {loc.T("article.html_disallowed", "description", violation)},
+			})
+			return Body{}, false
+		}
+	}
+
+	body.HTML = h.sanitizer.Sanitize(body.HTML, body.Format)
+	return body, true
+}
+
+// fillExcerpt: short_description verilmemisse (veya bossa) description'dan duz metin ozet uretir.
+func (h *Handler) fillExcerpt(description string, short **string) {
+	if *short == nil || strings.TrimSpace(**short) == "" {
+		excerpt := h.sanitizer.Excerpt(description)
+		*short = &excerpt
+	}
+}
+
+// sendTransitionError: newTransition hatalarini HTTP cevabina cevirir.
+func sendTransitionError(c *gin.Context, err error, from, to Status) {
+	switch {
+	case errors.Is(err, ErrTransitionForbidden):
+		api.SendError(c, http.StatusForbidden, "auth.forbidden", nil)
+	case errors.Is(err, ErrPublishAtPast):
+		loc := i18n.FromContext(c)
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("publish_at"): {loc.T("article.publish_at_past")},
+		})
+	default:
+		api.SendError(c, http.StatusConflict, "article.invalid_transition", nil, from, to)
+	}
+}
+
+func sendRepositoryError(c *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, id)
+	case errors.Is(err, database.ErrVersionMismatch):
+		api.SendError(c, http.StatusPreconditionFailed, "request.precondition_failed", nil)
+	case errors.Is(err, ErrSlugTaken):
+		api.SendError(c, http.StatusConflict, "article.slug_taken", nil)
+	case errors.Is(err, ErrInvalidReference):
+		api.SendError(c, http.StatusUnprocessableEntity, "article.invalid_reference", nil)
+	default:
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
+}
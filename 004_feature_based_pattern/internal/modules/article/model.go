@@ -0,0 +1,80 @@
+package article
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"feature-base-starter-kit/pkg/api"
+)
+
+// Article: articles tablosundaki bir kayit. CategoryIDs article_categories, Tags (etiket isimleri) article_tags tablosundan gelir.
+type Article struct {
+	ID               int64   `json:"id" xml:"id" yaml:"id"`
+	Title            string  `json:"title" xml:"title" yaml:"title"`
+	Slug             string  `json:"slug" xml:"slug" yaml:"slug"`
+	ShortDescription *string `json:"short_description" xml:"short_description" yaml:"short_description"`
+	Description      string  `json:"description" xml:"description" yaml:"description"` // her zaman sanitize edilmis HTML
+	// DescriptionFormat markdown ise DescriptionSource duzenleme icin kaynak metin, TOC basliklardan uretilen icindekilerdir
+	DescriptionFormat Format       `json:"description_format" xml:"description_format" yaml:"description_format"`
+	DescriptionSource *string      `json:"description_source" xml:"description_source" yaml:"description_source"`
+	TOC               TOC          `json:"toc" xml:"toc>heading" yaml:"toc"`
+	IsActive          bool         `json:"is_active" xml:"is_active" yaml:"is_active"`
+	UserID            *int64       `json:"user_id" xml:"user_id" yaml:"user_id"`
+	SEOSettings       *SEOSettings `json:"seo_settings" xml:"seo_settings" yaml:"seo_settings"`
+	CategoryIDs       []int64      `json:"category_ids" xml:"category_ids>id" yaml:"category_ids"`
+	Tags              []string     `json:"tags" xml:"tags>tag" yaml:"tags"`                     // isme gore sirali
+	Status            Status       `json:"status" xml:"status" yaml:"status"`                   // yayin akisi, bkz. workflow.go
+	PublishedAt       *time.Time   `json:"published_at" xml:"published_at" yaml:"published_at"` // scheduled ise planlanan yayin zamani
+	Version           int64        `json:"version" xml:"version" yaml:"version"`                // her guncellemede artar, ETag olarak gonderilir
+	CreatedAt         time.Time    `json:"created_at" xml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at" xml:"updated_at" yaml:"updated_at"`
+	DeletedAt         *time.Time   `json:"deleted_at,omitempty" xml:"deleted_at,omitempty" yaml:"deleted_at,omitempty"` // soft delete
+}
+
+// ETag: api.Versioned, bkz. api.CheckIfMatch.
+func (a Article) ETag() string {
+	return api.VersionETag(a.Version)
+}
+
+// StatusChange: article_status_history tablosundaki bir durum gecisi.
+type StatusChange struct {
+	ID         int64     `json:"id" xml:"id" yaml:"id"`
+	ArticleID  int64     `json:"article_id" xml:"article_id" yaml:"article_id"`
+	FromStatus Status    `json:"from_status" xml:"from_status" yaml:"from_status"`
+	ToStatus   Status    `json:"to_status" xml:"to_status" yaml:"to_status"`
+	Actor      string    `json:"actor" xml:"actor" yaml:"actor"` // gecisi yapan rol veya scheduler icin "system"
+	Note       *string   `json:"note" xml:"note" yaml:"note"`
+	CreatedAt  time.Time `json:"created_at" xml:"created_at" yaml:"created_at"`
+}
+
+// SEOSettings: seo_settings kolonu (postgres: JSONB, mysql: JSON).
+type SEOSettings struct {
+	MetaTitle       string   `json:"meta_title,omitempty" xml:"meta_title,omitempty" yaml:"meta_title,omitempty"`
+	MetaDescription string   `json:"meta_description,omitempty" xml:"meta_description,omitempty" yaml:"meta_description,omitempty"`
+	Keywords        []string `json:"keywords,omitempty" xml:"keywords>keyword,omitempty" yaml:"keywords,omitempty"`
+}
+
+// Value: JSON string olarak yazilir. MySQL JSON kolonu []byte'i binary kabul edip reddettigi icin string kullanilir.
+func (s SEOSettings) Value() (driver.Value, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan: driver'a gore deger []byte veya string olarak gelir.
+func (s *SEOSettings) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*s = SEOSettings{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	}
+	return fmt.Errorf("article: cannot scan %T into SEOSettings", src)
+}
@@ -0,0 +1,594 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+var (
+	// ErrSlugTaken: slug alaninda unique ihlali.
+	ErrSlugTaken = errors.New("article: slug already taken")
+	// ErrInvalidReference: user_id veya category_ids var olmayan bir kayda isaret ediyor (foreign key ihlali).
+	ErrInvalidReference = errors.New("article: referenced user or category does not exist")
+)
+
+// Repository: article modulunun veritabani islemleri. Bulunamayan kayitlar icin database.ErrNotFound doner.
+// Silinen (deleted_at dolu) kayitlar, withDeleted / ListOptions.WithDeleted verilmedikce sonuclara dahil edilmez.
+type Repository interface {
+	// Create: makaleyi, CategoryIDs ve Tags iliskilerini ekler (olmayan etiketler olusturulur). Birden fazla sorgu calistirdigi icin TxManager.WithinTx icinde cagrilmalidir.
+	Create(ctx context.Context, a *Article) error
+	GetByID(ctx context.Context, id int64, withDeleted bool) (*Article, error)
+	List(ctx context.Context, opts ListOptions) ([]Article, int, error)
+	// Export: List'in filtreleriyle (Limit/Offset haric) tum makaleleri id sirasiyla cursor'dan okuyup fn'e verir.
+	// Iliskiler (CategoryIDs, Tags) yuklenmez; fn ayni baglantiyi kullanan sorgu calistirmamalidir (sqlite tek baglanti kullanir).
+	Export(ctx context.Context, opts ListOptions, fn func(*Article) error) error
+	// Update: makaleyi gunceller, CategoryIDs / Tags nil degilse iliskiler bu listeyle degistirilir (WithinTx icinde cagrilmalidir).
+	// a.Version > 0 ise sadece veritabanindaki version ayniysa gunceller, degilse database.ErrVersionMismatch doner.
+	Update(ctx context.Context, a *Article) error
+	// Patch: sadece changes'teki kolonlari gunceller; "category_ids" ([]int64) / "tags" ([]string) varsa iliskiler bu listeyle degistirilir
+	// (WithinTx icinde cagrilmalidir). changes bos ise yazma yapilmaz. version Update'teki gibi kosulludur.
+	Patch(ctx context.Context, id, version int64, changes map[string]any) (*Article, error)
+	// Delete: soft delete, kayit Restore ile geri alinabilir. article_categories ve article_tags iliskileri korunur.
+	// version > 0 ise Update gibi kosulludur.
+	Delete(ctx context.Context, id, version int64) error
+	// Restore: version > 0 ise kosulludur; silinmis kayit varsa ama version degismisse database.ErrVersionMismatch doner.
+	Restore(ctx context.Context, id, version int64) (*Article, error)
+	// Purge: before'dan once silinmis kayitlari kalici olarak siler, silinen kayit sayisini dondurur.
+	Purge(ctx context.Context, before time.Time) (int64, error)
+	// Transition: durumu ve published_at'i gunceller, gecisi article_status_history'ye yazar (WithinTx icinde cagrilmalidir).
+	// Kayit t.From durumunda degilse veya version > 0 ve degismisse database.ErrVersionMismatch doner.
+	Transition(ctx context.Context, id, version int64, t Transition) (*Article, error)
+	// History: makalenin durum gecisleri, eskiden yeniye.
+	History(ctx context.Context, id int64) ([]StatusChange, error)
+	// DueForPublishing: published_at'i now'dan once olan scheduled makaleler (en fazla limit adet), bkz. Scheduler.
+	DueForPublishing(ctx context.Context, now time.Time, limit int) ([]Article, error)
+	// Search: yayindaki (published, aktif, silinmemis) makalelerde tam metin aramasi yapar, alaka duzeyine gore siralar; ikinci deger toplam sonuc sayisidir.
+	// postgres: tsvector + GIN, mysql: FULLTEXT, sqlite: bellekteki indeks (pkg/search).
+	Search(ctx context.Context, opts SearchOptions) ([]SearchResult, int, error)
+}
+
+// ListOptions: listeleme filtreleri.
+type ListOptions struct {
+	Limit       int
+	Offset      int
+	WithDeleted bool
+	// CategoryID > 0 ise sadece bu kategorideki makaleler; IncludeDescendants ile alt kategorilerdekiler de (WITH RECURSIVE)
+	CategoryID         int64
+	IncludeDescendants bool
+	Tag                string // etiket slug'i, bos degilse sadece bu etiketteki makaleler
+}
+
+// listFilter: List ve Export'un ortak filtreleri; sorgunun basina eklenecek WITH, WHERE ve parametreleri.
+func listFilter(opts ListOptions) (with, where string, args []any) {
+	var conditions []string
+	if !opts.WithDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+	if opts.CategoryID > 0 {
+		if opts.IncludeDescendants {
+			with = categoryDescendants
+			conditions = append(conditions, "id IN (SELECT article_id FROM article_categories WHERE category_id IN (SELECT id FROM descendants))")
+		} else {
+			conditions = append(conditions, "id IN (SELECT article_id FROM article_categories WHERE category_id = ?)")
+		}
+		args = append(args, opts.CategoryID)
+	}
+	if opts.Tag != "" {
+		conditions = append(conditions, "id IN (SELECT ta.article_id FROM article_tags ta JOIN tags t ON t.id = ta.tag_id WHERE t.slug = ?)")
+		args = append(args, opts.Tag)
+	}
+
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return with, where, args
+}
+
+// categoryDescendants: kategori ve tum alt kategorilerinin id'leri. UNION tekrar eden satirlari eledigi icin
+// veride dongu olsa bile sonlanir; postgres, mysql 8 ve sqlite ayni sozdizimini destekler.
+const categoryDescendants = `WITH RECURSIVE descendants (id) AS (
+	SELECT id FROM categories WHERE id = ?
+	UNION
+	SELECT c.id FROM categories c JOIN descendants d ON c.parent_id = d.id
+) `
+
+type sqlRepository struct {
+	db     *database.DB
+	memory memoryIndex // sadece sqlite'ta kullanilir, bkz. Search
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const articleColumns = "id, title, slug, short_description, description, description_format, description_source, toc, is_active, user_id, seo_settings, status, published_at, version, created_at, updated_at, deleted_at"
+
+func scanArticle(row interface{ Scan(...any) error }) (*Article, error) {
+	var (
+		a           Article
+		userID      sql.NullInt64
+		short       sql.NullString
+		source      sql.NullString
+		seo         sql.Null[SEOSettings]
+		publishedAt sql.NullTime
+		deletedAt   sql.NullTime
+	)
+	if err := row.Scan(&a.ID, &a.Title, &a.Slug, &short, &a.Description, &a.DescriptionFormat, &source, &a.TOC, &a.IsActive, &userID, &seo,
+		&a.Status, &publishedAt, &a.Version, &a.CreatedAt, &a.UpdatedAt, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	if short.Valid {
+		a.ShortDescription = &short.String
+	}
+	if source.Valid {
+		a.DescriptionSource = &source.String
+	}
+	if userID.Valid {
+		a.UserID = &userID.Int64
+	}
+	if seo.Valid {
+		a.SEOSettings = &seo.V
+	}
+	if publishedAt.Valid {
+		a.PublishedAt = &publishedAt.Time
+	}
+	if deletedAt.Valid {
+		a.DeletedAt = &deletedAt.Time
+	}
+	return &a, nil
+}
+
+// notDeleted: withDeleted false ise sorguya eklenecek kosul.
+func notDeleted(withDeleted bool) string {
+	if withDeleted {
+		return ""
+	}
+	return " AND deleted_at IS NULL"
+}
+
+// seoValue: nil ise kolona NULL yazilir.
+func seoValue(s *SEOSettings) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+func (r *sqlRepository) Create(ctx context.Context, a *Article) error {
+	id, err := r.db.Insert(ctx, `INSERT INTO articles (title, slug, short_description, description, description_format, description_source, toc,
+		is_active, user_id, seo_settings) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.Title, a.Slug, a.ShortDescription, a.Description, a.DescriptionFormat, a.DescriptionSource, a.TOC,
+		a.IsActive, a.UserID, seoValue(a.SEOSettings))
+	if err != nil {
+		return r.mapError(err)
+	}
+
+	if err := r.addCategories(ctx, id, a.CategoryIDs); err != nil {
+		return r.mapError(err)
+	}
+	if err := r.addTags(ctx, id, a.Tags); err != nil {
+		return err
+	}
+
+	return r.reload(ctx, id, a)
+}
+
+func (r *sqlRepository) GetByID(ctx context.Context, id int64, withDeleted bool) (*Article, error) {
+	a, err := scanArticle(r.db.QueryRowContext(ctx, "SELECT "+articleColumns+" FROM articles WHERE id = ?"+notDeleted(withDeleted), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadRelations(ctx, []*Article{a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *sqlRepository) List(ctx context.Context, opts ListOptions) ([]Article, int, error) {
+	with, where, args := listFilter(opts)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, with+"SELECT COUNT(*) FROM articles"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, with+"SELECT "+articleColumns+" FROM articles"+where+" ORDER BY id LIMIT ? OFFSET ?",
+		append(args, opts.Limit, opts.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var articles []Article
+	for rows.Next() {
+		a, err := scanArticle(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		articles = append(articles, *a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	ptrs := make([]*Article, len(articles))
+	for i := range articles {
+		ptrs[i] = &articles[i]
+	}
+	if err := r.loadRelations(ctx, ptrs); err != nil {
+		return nil, 0, err
+	}
+
+	return articles, total, nil
+}
+
+func (r *sqlRepository) Export(ctx context.Context, opts ListOptions, fn func(*Article) error) error {
+	with, where, args := listFilter(opts)
+
+	rows, err := r.db.QueryContext(ctx, with+"SELECT "+articleColumns+" FROM articles"+where+" ORDER BY id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a, err := scanArticle(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(a); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *sqlRepository) Update(ctx context.Context, a *Article) error {
+	q, args := database.WithVersion(`UPDATE articles SET title = ?, slug = ?, short_description = ?, description = ?,
+		description_format = ?, description_source = ?, toc = ?, is_active = ?, user_id = ?, seo_settings = ?, version = version + 1
+		WHERE id = ? AND deleted_at IS NULL`,
+		a.Version, a.Title, a.Slug, a.ShortDescription, a.Description, a.DescriptionFormat, a.DescriptionSource, a.TOC,
+		a.IsActive, a.UserID, seoValue(a.SEOSettings), a.ID)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return r.mapError(err)
+	}
+	if err := r.checkAffected(ctx, res, a.ID); err != nil {
+		return err
+	}
+
+	if a.CategoryIDs != nil {
+		if _, err := r.db.ExecContext(ctx, "DELETE FROM article_categories WHERE article_id = ?", a.ID); err != nil {
+			return err
+		}
+		if err := r.addCategories(ctx, a.ID, a.CategoryIDs); err != nil {
+			return r.mapError(err)
+		}
+	}
+	if a.Tags != nil {
+		if err := r.replaceTags(ctx, a.ID, a.Tags); err != nil {
+			return err
+		}
+	}
+
+	return r.reload(ctx, a.ID, a)
+}
+
+func (r *sqlRepository) Patch(ctx context.Context, id, version int64, changes map[string]any) (*Article, error) {
+	if len(changes) == 0 {
+		return r.GetByID(ctx, id, false)
+	}
+
+	columns := maps.Clone(changes)
+	categoryIDs, replaceCategories := columns["category_ids"].([]int64)
+	tags, replaceTags := columns["tags"].([]string)
+	delete(columns, "category_ids")
+	delete(columns, "tags")
+
+	// sadece iliskiler degisse de version arttirilir, ETag kaydin tamamini temsil eder
+	set, args := r.db.SetClause(columns)
+	if set != "" {
+		set += ", "
+	}
+	q, args := database.WithVersion("UPDATE articles SET "+set+"version = version + 1 WHERE id = ? AND deleted_at IS NULL",
+		version, append(args, id)...)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, r.mapError(err)
+	}
+	if err := r.checkAffected(ctx, res, id); err != nil {
+		return nil, err
+	}
+
+	if replaceCategories {
+		if _, err := r.db.ExecContext(ctx, "DELETE FROM article_categories WHERE article_id = ?", id); err != nil {
+			return nil, err
+		}
+		if err := r.addCategories(ctx, id, categoryIDs); err != nil {
+			return nil, r.mapError(err)
+		}
+	}
+	if replaceTags {
+		if err := r.replaceTags(ctx, id, tags); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.GetByID(ctx, id, false)
+}
+
+// Delete: deleted_at uygulama tarafinda (UTC) verilir (bkz. user repository).
+func (r *sqlRepository) Delete(ctx context.Context, id, version int64) error {
+	q, args := database.WithVersion("UPDATE articles SET deleted_at = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL",
+		version, time.Now().UTC(), id)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	return r.checkAffected(ctx, res, id)
+}
+
+func (r *sqlRepository) Restore(ctx context.Context, id, version int64) (*Article, error) {
+	q, args := database.WithVersion("UPDATE articles SET deleted_at = NULL, version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL",
+		version, id)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		// Silinmis kayit var ama version degismis
+		if version > 0 {
+			if current, err := r.GetByID(ctx, id, true); err == nil && current.DeletedAt != nil {
+				return nil, database.ErrVersionMismatch
+			}
+		}
+		return nil, database.ErrNotFound
+	}
+	return r.GetByID(ctx, id, false)
+}
+
+// Purge: article_categories ve article_tags satirlari ON DELETE CASCADE ile silinir. Kullanilmayan etiketler kalir.
+func (r *sqlRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM articles WHERE deleted_at IS NOT NULL AND deleted_at < ?", before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (r *sqlRepository) Transition(ctx context.Context, id, version int64, t Transition) (*Article, error) {
+	q, args := database.WithVersion("UPDATE articles SET status = ?, published_at = ?, version = version + 1 WHERE id = ? AND status = ? AND deleted_at IS NULL",
+		version, t.To, t.PublishedAt, id, t.From)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkAffected(ctx, res, id); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.db.ExecContext(ctx, "INSERT INTO article_status_history (article_id, from_status, to_status, actor, note) VALUES (?, ?, ?, ?, ?)",
+		id, t.From, t.To, t.Actor, t.Note); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id, false)
+}
+
+func (r *sqlRepository) History(ctx context.Context, id int64) ([]StatusChange, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, article_id, from_status, to_status, actor, note, created_at
+		FROM article_status_history WHERE article_id = ? ORDER BY id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []StatusChange{}
+	for rows.Next() {
+		var (
+			c    StatusChange
+			note sql.NullString
+		)
+		if err := rows.Scan(&c.ID, &c.ArticleID, &c.FromStatus, &c.ToStatus, &c.Actor, &note, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		if note.Valid {
+			c.Note = &note.String
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+func (r *sqlRepository) DueForPublishing(ctx context.Context, now time.Time, limit int) ([]Article, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+articleColumns+" FROM articles WHERE status = ? AND published_at <= ? AND deleted_at IS NULL ORDER BY published_at, id LIMIT ?",
+		StatusScheduled, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var articles []Article
+	for rows.Next() {
+		a, err := scanArticle(rows)
+		if err != nil {
+			return nil, err
+		}
+		articles = append(articles, *a)
+	}
+	return articles, rows.Err()
+}
+
+// addCategories: iliskileri ekler, zaten var olan iliskiler atlanir (upsert, DO NOTHING).
+func (r *sqlRepository) addCategories(ctx context.Context, articleID int64, categoryIDs []int64) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+
+	q := r.db.Dialect.Upsert("article_categories", []string{"article_id", "category_id"}, []string{"article_id", "category_id"}, nil)
+	for _, categoryID := range categoryIDs {
+		if _, err := r.db.ExecContext(ctx, q, articleID, categoryID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTags: etiketleri slug'a gore olusturur (varsa dokunulmaz) ve makaleye baglar. Etiket id'si upsert'ten sonra
+// slug ile okunur; RETURNING / LAST_INSERT_ID cakisma durumunda id dondurmedigi icin tum dialect'lerde ayni yol izlenir.
+func (r *sqlRepository) addTags(ctx context.Context, articleID int64, names []string) error {
+	tags := normalizeTags(names)
+	if len(tags) == 0 {
+		return nil
+	}
+
+	insertTag := r.db.Dialect.Upsert("tags", []string{"name", "slug"}, []string{"slug"}, nil)
+	link := r.db.Dialect.Upsert("article_tags", []string{"article_id", "tag_id"}, []string{"article_id", "tag_id"}, nil)
+	for _, t := range tags {
+		if _, err := r.db.ExecContext(ctx, insertTag, t.Name, t.Slug); err != nil {
+			return err
+		}
+
+		var tagID int64
+		if err := r.db.QueryRowContext(ctx, "SELECT id FROM tags WHERE slug = ?", t.Slug).Scan(&tagID); err != nil {
+			return err
+		}
+		if _, err := r.db.ExecContext(ctx, link, articleID, tagID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *sqlRepository) replaceTags(ctx context.Context, articleID int64, names []string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM article_tags WHERE article_id = ?", articleID); err != nil {
+		return err
+	}
+	return r.addTags(ctx, articleID, names)
+}
+
+// loadRelations: makalelerin kategori id'lerini ve etiketlerini doldurur.
+func (r *sqlRepository) loadRelations(ctx context.Context, articles []*Article) error {
+	if err := r.loadCategories(ctx, articles); err != nil {
+		return err
+	}
+	return r.loadTags(ctx, articles)
+}
+
+// loadCategories: makalelerin kategori id'lerini tek sorguda doldurur.
+func (r *sqlRepository) loadCategories(ctx context.Context, articles []*Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*Article, len(articles))
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		a.CategoryIDs = []int64{}
+		byID[a.ID] = a
+		args[i] = a.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT article_id, category_id FROM article_categories WHERE article_id IN ("+
+		database.Placeholders(len(args))+") ORDER BY article_id, category_id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var articleID, categoryID int64
+		if err := rows.Scan(&articleID, &categoryID); err != nil {
+			return err
+		}
+		if a, ok := byID[articleID]; ok {
+			a.CategoryIDs = append(a.CategoryIDs, categoryID)
+		}
+	}
+	return rows.Err()
+}
+
+// loadTags: makalelerin etiket isimlerini tek sorguda doldurur.
+func (r *sqlRepository) loadTags(ctx context.Context, articles []*Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*Article, len(articles))
+	args := make([]any, len(articles))
+	for i, a := range articles {
+		a.Tags = []string{}
+		byID[a.ID] = a
+		args[i] = a.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT ta.article_id, t.name FROM article_tags ta JOIN tags t ON t.id = ta.tag_id WHERE ta.article_id IN ("+
+		database.Placeholders(len(args))+") ORDER BY ta.article_id, t.name", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			articleID int64
+			name      string
+		)
+		if err := rows.Scan(&articleID, &name); err != nil {
+			return err
+		}
+		if a, ok := byID[articleID]; ok {
+			a.Tags = append(a.Tags, name)
+		}
+	}
+	return rows.Err()
+}
+
+// checkAffected: hicbir satir guncellenmediyse kaydin olmadigini veya version'inin degistigini ayirt eder.
+func (r *sqlRepository) checkAffected(ctx context.Context, res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil || n > 0 {
+		return err
+	}
+
+	if _, err := r.GetByID(ctx, id, false); err != nil {
+		return err
+	}
+	return database.ErrVersionMismatch
+}
+
+func (r *sqlRepository) reload(ctx context.Context, id int64, a *Article) error {
+	fresh, err := r.GetByID(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	*a = *fresh
+	return nil
+}
+
+func (r *sqlRepository) mapError(err error) error {
+	switch {
+	case r.db.Dialect.IsUniqueViolation(err):
+		return ErrSlugTaken
+	case r.db.Dialect.IsForeignKeyViolation(err):
+		return ErrInvalidReference
+	}
+	return err
+}
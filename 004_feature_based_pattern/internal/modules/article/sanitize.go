@@ -0,0 +1,195 @@
+package article
+
+import (
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"golang.org/x/net/html"
+
+	"feature-base-starter-kit/pkg/markdown"
+)
+
+// SanitizeOptions: description icin allow-list politikasi (bkz. config.HTMLConfig).
+type SanitizeOptions struct {
+	Tags       []string
+	Attributes []string // "href": tum izinli etiketlerde, "a:href": sadece a etiketinde
+	URLSchemes []string
+	// Reject: izin verilmeyen HTML temizlenmek yerine 422 ile reddedilir
+	Reject        bool
+	ExcerptLength int
+}
+
+// Sanitizer: description HTML'ini allow-list'e gore temizler (bluemonday) ve short_description icin duz metin ozet uretir.
+// Markdown'dan render edilen HTML'de, allow-list'e ek olarak renderer'in urettigi etiket ve attribute'lara da
+// (baslik id'leri, kod renklendirme siniflari; bkz. markdown.Tags, markdown.Attributes) izin verilir.
+type Sanitizer struct {
+	lists         map[Format]*allowList
+	reject        bool
+	excerptLength int
+}
+
+// allowList: bir format icin bluemonday politikasi ve Check'in kullandigi ayni kurallar.
+type allowList struct {
+	policy  *bluemonday.Policy
+	tags    map[string]bool
+	attrs   map[string]map[string]bool // etiket -> attribute, "*": tum izinli etiketler
+	schemes map[string]bool
+}
+
+// urlAttributes: degeri URL olan attribute'lar, sema (scheme) kontrolu yapilir.
+var urlAttributes = map[string]bool{"href": true, "src": true, "cite": true, "action": true, "formaction": true, "poster": true}
+
+func NewSanitizer(opts SanitizeOptions) *Sanitizer {
+	return &Sanitizer{
+		lists: map[Format]*allowList{
+			FormatHTML: newAllowList(opts.Tags, opts.Attributes, opts.URLSchemes),
+			FormatMarkdown: newAllowList(slices.Concat(opts.Tags, markdown.Tags),
+				slices.Concat(opts.Attributes, markdown.Attributes), opts.URLSchemes),
+		},
+		reject:        opts.Reject,
+		excerptLength: opts.ExcerptLength,
+	}
+}
+
+// newAllowList: attributes "href" (tum izinli etiketlerde) veya "a:href" (sadece a etiketinde) seklindedir.
+func newAllowList(tags, attributes, schemes []string) *allowList {
+	l := &allowList{
+		policy:  bluemonday.NewPolicy(),
+		tags:    map[string]bool{},
+		attrs:   map[string]map[string]bool{},
+		schemes: map[string]bool{},
+	}
+
+	for _, tag := range tags {
+		l.tags[strings.ToLower(tag)] = true
+	}
+	l.policy.AllowElements(tags...)
+
+	for _, attr := range attributes {
+		tag, name, ok := strings.Cut(strings.ToLower(attr), ":")
+		if !ok {
+			tag, name = "*", tag
+		}
+		if l.attrs[tag] == nil {
+			l.attrs[tag] = map[string]bool{}
+		}
+		l.attrs[tag][name] = true
+
+		if tag == "*" {
+			l.policy.AllowAttrs(name).OnElements(tags...)
+		} else {
+			l.policy.AllowAttrs(name).OnElements(tag)
+		}
+	}
+
+	for _, scheme := range schemes {
+		l.schemes[strings.ToLower(scheme)] = true
+	}
+	l.policy.AllowURLSchemes(schemes...)
+	l.policy.AllowRelativeURLs(true)
+	l.policy.RequireParseableURLs(true)
+
+	return l
+}
+
+// Reject: reject modunda mi? (Check'in dondurdugu ihlal varsa istek reddedilmelidir)
+func (s *Sanitizer) Reject() bool {
+	return s.reject
+}
+
+// Check: politikaya uymayan ilk etiketi, attribute'u veya URL semasini dondurur (orn: "<script>", "p onclick", "href javascript:").
+// HTML politikaya uygunsa bos string doner. format, HTML'in nereden geldigidir (bkz. Sanitizer).
+func (s *Sanitizer) Check(text string, format Format) string {
+	l := s.lists[format]
+
+	z := html.NewTokenizer(strings.NewReader(text))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return ""
+			}
+			return z.Err().Error()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if !l.tags[tok.Data] {
+				return "<" + tok.Data + ">"
+			}
+
+			for _, attr := range tok.Attr {
+				if !l.attrs["*"][attr.Key] && !l.attrs[tok.Data][attr.Key] {
+					return tok.Data + " " + attr.Key
+				}
+				if urlAttributes[attr.Key] {
+					u, err := url.Parse(strings.TrimSpace(attr.Val))
+					if err != nil {
+						return attr.Key + " " + attr.Val
+					}
+					if u.Scheme != "" && !l.schemes[strings.ToLower(u.Scheme)] {
+						return attr.Key + " " + strings.ToLower(u.Scheme) + ":"
+					}
+				}
+			}
+		}
+	}
+}
+
+// Sanitize: izin verilmeyen etiketleri (script/style icerikleriyle birlikte) ve attribute'lari temizler.
+func (s *Sanitizer) Sanitize(text string, format Format) string {
+	return s.lists[format].policy.Sanitize(text)
+}
+
+// Excerpt: HTML'den en fazla ExcerptLength karakterlik duz metin ozet uretir, kelime sinirindan keser ve "…" ekler.
+func (s *Sanitizer) Excerpt(text string) string {
+	plain := []rune(plainText(text))
+	if len(plain) <= s.excerptLength {
+		return string(plain)
+	}
+
+	cut := string(plain[:s.excerptLength-1])
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:") + "…"
+}
+
+// blockElements: duz metinde kelime sinirina karsilik gelen etiketler; satir ici etiketler (a, strong...) bosluk eklemez.
+var blockElements = map[string]bool{
+	"p": true, "br": true, "hr": true, "div": true, "li": true, "ul": true, "ol": true, "blockquote": true, "pre": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "table": true, "tr": true, "th": true, "td": true,
+}
+
+// plainText: HTML'in metin icerigi (entity'ler cozulmus, bosluklar tek bosluga indirilmis). Kod bloklari (pre) ozete alinmaz.
+func plainText(text string) string {
+	var b strings.Builder
+
+	pre := 0
+	z := html.NewTokenizer(strings.NewReader(text))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return strings.Join(strings.Fields(b.String()), " ")
+		case html.TextToken:
+			if pre == 0 {
+				b.Write(z.Text())
+			}
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			if string(name) == "pre" {
+				switch tt {
+				case html.StartTagToken:
+					pre++
+				case html.EndTagToken:
+					pre = max(pre-1, 0)
+				}
+			}
+			if blockElements[string(name)] {
+				b.WriteByte(' ')
+			}
+		}
+	}
+}
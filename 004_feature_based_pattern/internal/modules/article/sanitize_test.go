@@ -0,0 +1,153 @@
+package article
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// testOptions: config.Default()'taki HTML allow-list'i.
+var testOptions = SanitizeOptions{
+	Tags: []string{"p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6", "strong", "b", "em", "i", "u", "s",
+		"blockquote", "code", "pre", "ul", "ol", "li", "a", "img", "table", "thead", "tbody", "tr", "th", "td"},
+	Attributes:    []string{"a:href", "a:title", "img:src", "img:alt", "img:title", "th:colspan", "td:colspan"},
+	URLSchemes:    []string{"http", "https", "mailto"},
+	ExcerptLength: 20,
+}
+
+// dangerous: sanitize edilmis HTML'de hicbiri kalmamali.
+var dangerous = []string{"<script", "alert(", "onclick", "onerror", "javascript:", "<iframe", "<style"}
+
+func TestSanitizer(t *testing.T) {
+	s := NewSanitizer(testOptions)
+
+	tests := []struct {
+		name      string
+		input     string
+		violation string // Check'in dondurmesi beklenen ihlal, bos ise politikaya uygun
+		want      string // Sanitize sonucu
+	}{
+		{"allowed", `<p>Hello <a href="https://example.com" title="x">link</a></p>`, "",
+			`<p>Hello <a href="https://example.com" title="x">link</a></p>`},
+		{"script tag", `<p>Hi</p><script>alert(1)</script>`, "<script>", `<p>Hi</p>`},
+		{"onclick", `<p onclick="alert(1)">Hi</p>`, "p onclick", `<p>Hi</p>`},
+		{"img onerror", `<img src="x.png" onerror="alert(1)">`, "img onerror", `<img src="x.png">`},
+		{"javascript href", `<a href="javascript:alert(1)">x</a>`, "href javascript:", `x`},
+		{"mixed case javascript href", `<a href="JaVaScRiPt:alert(1)">x</a>`, "href javascript:", `x`},
+		{"javascript href with leading space", `<a href="  javascript:alert(1)">x</a>`, "href javascript:", `x`},
+		{"entity encoded javascript href", `<a href="&#106;avascript:alert(1)">x</a>`, "href javascript:", `x`},
+		{"relative href", `<a href="/articles/1">x</a>`, "", `<a href="/articles/1">x</a>`},
+		{"iframe", `<iframe src="https://evil.example"></iframe><p>ok</p>`, "<iframe>", `<p>ok</p>`},
+		{"style attribute", `<p style="color:red">x</p>`, "p style", `<p>x</p>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Check(tt.input, FormatHTML); got != tt.violation {
+				t.Errorf("Check = %q, want %q", got, tt.violation)
+			}
+
+			got := s.Sanitize(tt.input, FormatHTML)
+			if got != tt.want {
+				t.Errorf("Sanitize = %q, want %q", got, tt.want)
+			}
+			assertSafe(t, got)
+		})
+	}
+}
+
+// TestSanitizerModes: handler reject modunda Check'in sonucuyla 422 doner, strip modunda Sanitize'in sonucunu yazar.
+func TestSanitizerModes(t *testing.T) {
+	input := `<p onclick="alert(1)">Hi</p><script>alert(2)</script>`
+
+	for _, reject := range []bool{true, false} {
+		opts := testOptions
+		opts.Reject = reject
+		s := NewSanitizer(opts)
+
+		if s.Reject() != reject {
+			t.Fatalf("Reject() = %v, want %v", s.Reject(), reject)
+		}
+		if violation := s.Check(input, FormatHTML); violation == "" {
+			t.Errorf("reject=%v: Check found no violation", reject)
+		}
+		if got := s.Sanitize(input, FormatHTML); got != "<p>Hi</p>" {
+			t.Errorf("reject=%v: Sanitize = %q", reject, got)
+		}
+	}
+}
+
+// TestSanitizerMarkdown: markdown renderer ham HTML'i (WithUnsafe) aynen gecirir, tek koruma Sanitizer'dir.
+func TestSanitizerMarkdown(t *testing.T) {
+	s := NewSanitizer(testOptions)
+
+	source := "# Title\n\nText with <span onclick=\"alert(1)\">raw</span> HTML.\n\n<script>alert(2)</script>\n\n" +
+		"[bad](javascript:alert(3)) [Bad](JaVaScRiPt:alert(4)) <img src=x onerror=alert(5)>\n\n```go\nfmt.Println(1)\n```\n"
+	body, err := render(FormatMarkdown, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body.HTML, "<script>") {
+		t.Fatalf("renderer no longer passes raw HTML through, update this test: %s", body.HTML)
+	}
+
+	if violation := s.Check(body.HTML, FormatMarkdown); violation == "" {
+		t.Error("Check found no violation in rendered markdown")
+	}
+
+	got := s.Sanitize(body.HTML, FormatMarkdown)
+	assertSafe(t, got)
+	for _, want := range []string{`<h1 id="title">Title</h1>`, "raw", `<pre class="chroma">`, "Println"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Sanitize dropped %q: %s", want, got)
+		}
+	}
+
+	// ayni politika html formatinda markdown'a ozel attribute'lara (baslik id'leri) izin vermez
+	if violation := s.Check(`<h1 id="title">Title</h1>`, FormatHTML); violation != "h1 id" {
+		t.Errorf("Check(html) = %q, want %q", violation, "h1 id")
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	s := NewSanitizer(testOptions) // ExcerptLength: 20
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"short", "<p>Kısa metin</p>", "Kısa metin"},
+		{"exact length", "<p>12345678901234567890</p>", "12345678901234567890"},
+		{"word boundary", "<p>Merhaba dünya, bugün hava çok güzel.</p>", "Merhaba dünya…"},
+		{"multibyte without spaces", "<p>ğüşıöçĞÜŞİÖÇğüşıöçĞÜŞİÖÇ</p>", "ğüşıöçĞÜŞİÖÇğüşıöçĞ…"},
+		{"cyrillic", "<p>Привет мир, это длинный текст</p>", "Привет мир, это…"},
+		{"block elements separate words", "<h1>Başlık</h1><p>Paragraf</p>", "Başlık Paragraf"},
+		{"code blocks skipped", "<p>Önce</p><pre><code>kod bloğu</code></pre><p>sonra</p>", "Önce sonra"},
+		{"entities decoded", "<p>Tom &amp; Jerry &lt;3</p>", "Tom & Jerry <3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Excerpt(tt.input)
+			if got != tt.want {
+				t.Errorf("Excerpt = %q, want %q", got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("Excerpt is not valid UTF-8: %q", got)
+			}
+			if n := utf8.RuneCountInString(got); n > testOptions.ExcerptLength {
+				t.Errorf("Excerpt has %d runes, limit is %d", n, testOptions.ExcerptLength)
+			}
+		})
+	}
+}
+
+func assertSafe(t *testing.T, html string) {
+	t.Helper()
+
+	lower := strings.ToLower(html)
+	for _, d := range dangerous {
+		if strings.Contains(lower, d) {
+			t.Errorf("sanitized HTML contains %q: %s", d, html)
+		}
+	}
+}
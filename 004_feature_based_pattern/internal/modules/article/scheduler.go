@@ -0,0 +1,80 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"feature-base-starter-kit/pkg/auth"
+	"feature-base-starter-kit/pkg/database"
+)
+
+// schedulerBatch: her turda en fazla bu kadar makale yayinlanir, kalanlar bir sonraki tura kalir.
+const schedulerBatch = 100
+
+// Scheduler: API sureci icinde calisir ve yayin zamani gelen scheduled makaleleri yayinlar.
+// Birden fazla surec ayni anda calisabilir: Transition'daki status kosulu sayesinde bir makale sadece bir kez yayinlanir.
+type Scheduler struct {
+	repo     Repository
+	tx       *database.TxManager
+	interval time.Duration
+}
+
+func NewScheduler(repo Repository, tx *database.TxManager, interval time.Duration) *Scheduler {
+	return &Scheduler{repo: repo, tx: tx, interval: interval}
+}
+
+// Run: ctx iptal edilene kadar her interval'de PublishDue'yu calistirir. Hatalar loglanir, bir sonraki turda tekrar denenir.
+// Dondugunde devam eden yayin transaction'i kalmaz; kapanista db bundan sonra kapatilmalidir.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if n, err := s.PublishDue(ctx, time.Now().UTC()); err != nil {
+			log.Printf("Article scheduler: %v", err)
+		} else if n > 0 {
+			log.Printf("Article scheduler: published %d article(s)", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDue: published_at'i now'dan once olan scheduled makaleleri yayinlar ve yayinlanan sayisini dondurur.
+// published_at planlanan zaman olarak kalir. Her makale kendi transaction'inda yayinlanir.
+func (s *Scheduler) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.repo.DueForPublishing(ctx, now, schedulerBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, a := range due {
+		// kapanista (ctx iptal) yeni yayina baslanmaz, baslamis transaction ise iptal edilmeden tamamlanir
+		if ctx.Err() != nil {
+			return published, nil
+		}
+
+		t := Transition{From: StatusScheduled, To: StatusPublished, PublishedAt: a.PublishedAt, Actor: auth.RoleSystem}
+		err := s.tx.WithinTx(context.WithoutCancel(ctx), func(ctx context.Context) error {
+			_, err := s.repo.Transition(ctx, a.ID, a.Version, t)
+			return err
+		})
+
+		switch {
+		case err == nil:
+			published++
+		case errors.Is(err, database.ErrVersionMismatch), errors.Is(err, database.ErrNotFound):
+			// bu arada baska bir surec yayinlamis, editor degistirmis veya makale silinmis
+		default:
+			return published, err
+		}
+	}
+	return published, nil
+}
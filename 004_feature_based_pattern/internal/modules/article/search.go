@@ -0,0 +1,244 @@
+package article
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/search"
+)
+
+// SearchResult: arama sonucu. Snippet, description'in eslesen kelimeleri <b> ile isaretlenmis bir parcasidir (HTML).
+type SearchResult struct {
+	Article `yaml:",inline"`
+	Rank    float64 `json:"rank" xml:"rank" yaml:"rank"`
+	Snippet string  `json:"snippet" xml:"snippet" yaml:"snippet"`
+}
+
+// SearchOptions: Lang istegin dilidir, postgres'te text search config'ini secer.
+type SearchOptions struct {
+	Query  string
+	Lang   string
+	Limit  int
+	Offset int
+}
+
+// searchable: aramada sadece okuyucularin gorebildigi makaleler doner (bkz. feed.published); taslak, incelemedeki,
+// zamanlanmis, arsivlenmis ve pasif makaleler yonetim listesinde (GET /articles) gorunur.
+const searchable = "status = 'published' AND is_active AND deleted_at IS NULL"
+
+// snippetWords: snippet'in en fazla kelime sayisi (ts_headline MaxWords ile ayni).
+const snippetWords = 35
+
+// textSearch: istegin diline gore postgres generated kolonu ve config'i (bkz. migrations/postgres/004_search.up.sql).
+func textSearch(lang string) (column, config string) {
+	if lang == "tr" {
+		return "search_tr", "turkish"
+	}
+	return "search_simple", "simple"
+}
+
+// rowScanner: scanArticle'a article kolonlarindan sonra gelen ek kolonlari (rank, snippet) tarar.
+type rowScanner struct {
+	row   interface{ Scan(...any) error }
+	extra []any
+}
+
+func (s rowScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
+
+func (r *sqlRepository) Search(ctx context.Context, opts SearchOptions) ([]SearchResult, int, error) {
+	switch r.db.Dialect.Name() {
+	case "postgres":
+		return r.searchPostgres(ctx, opts)
+	case "mysql":
+		return r.searchMySQL(ctx, opts)
+	}
+	return r.searchMemory(ctx, opts)
+}
+
+// searchPostgres: websearch_to_tsquery ("tirnakli ifade", -haric, or) ile arar, ts_rank ile siralar.
+// ts_headline sadece sayfadaki kayitlar icin hesaplanir.
+func (r *sqlRepository) searchPostgres(ctx context.Context, opts SearchOptions) ([]SearchResult, int, error) {
+	column, config := textSearch(opts.Lang)
+	tsquery := fmt.Sprintf("websearch_to_tsquery('%s', ?)", config)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM articles WHERE "+searchable+" AND "+column+" @@ "+tsquery,
+		opts.Query).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	q := fmt.Sprintf(`SELECT %[1]s, rank, ts_headline('%[2]s', regexp_replace(description, '<[^>]*>', ' ', 'g'), %[3]s, 'MaxWords=%[5]d, MinWords=15')
+		FROM (
+			SELECT %[1]s, ts_rank(%[4]s, %[3]s) AS rank FROM articles
+			WHERE `+searchable+` AND %[4]s @@ %[3]s
+			ORDER BY rank DESC, id LIMIT ? OFFSET ?
+		) ranked ORDER BY rank DESC, id`, articleColumns, config, tsquery, column, snippetWords)
+
+	rows, err := r.db.QueryContext(ctx, q, opts.Query, opts.Query, opts.Query, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results, err := r.scanResults(ctx, rows, func(*Article) string { return "" })
+	return results, total, err
+}
+
+// searchMySQL: FULLTEXT indeksi uzerinde dogal dil modunda arar, snippet uygulamada uretilir.
+func (r *sqlRepository) searchMySQL(ctx context.Context, opts SearchOptions) ([]SearchResult, int, error) {
+	const match = "MATCH (title, short_description, description) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM articles WHERE "+searchable+" AND "+match,
+		opts.Query).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+articleColumns+", "+match+" AS score, '' FROM articles WHERE "+searchable+" AND "+match+
+		" ORDER BY score DESC, id LIMIT ? OFFSET ?", opts.Query, opts.Query, opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results, err := r.scanResults(ctx, rows, func(a *Article) string { return search.Highlight(a.Description, opts.Query, snippetWords) })
+	return results, total, err
+}
+
+// scanResults: satirlari (article kolonlari, rank, snippet) okur ve kategorileri doldurur.
+// Veritabani snippet uretmediyse (bos string) snippet fonksiyonu kullanilir.
+func (r *sqlRepository) scanResults(ctx context.Context, rows *sql.Rows, snippet func(*Article) string) ([]SearchResult, error) {
+	var results []SearchResult
+	for rows.Next() {
+		var res SearchResult
+		a, err := scanArticle(rowScanner{row: rows, extra: []any{&res.Rank, &res.Snippet}})
+		if err != nil {
+			return nil, err
+		}
+		res.Article = *a
+		if res.Snippet == "" {
+			res.Snippet = snippet(a)
+		}
+		results = append(results, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, r.loadResultRelations(ctx, results)
+}
+
+func (r *sqlRepository) loadResultRelations(ctx context.Context, results []SearchResult) error {
+	ptrs := make([]*Article, len(results))
+	for i := range results {
+		ptrs[i] = &results[i].Article
+	}
+	return r.loadRelations(ctx, ptrs)
+}
+
+// memoryIndex: tam metin aramasi olmayan dialect'ler (sqlite) icin bellekteki indeks.
+// Tablo degistiginde (kayit sayisi, version toplami veya son updated_at farkliysa) aramadan once bastan olusturulur;
+// boylece yazma islemlerine (ve rollback olan transaction'lara) baglanmak gerekmez. Kucuk veri setleri icindir.
+type memoryIndex struct {
+	mu    sync.Mutex
+	stamp string
+	index *search.Index
+}
+
+func (r *sqlRepository) searchMemory(ctx context.Context, opts SearchOptions) ([]SearchResult, int, error) {
+	index, err := r.searchIndex(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hits := index.Search(opts.Query)
+	total := len(hits)
+	hits = hits[min(opts.Offset, total):min(opts.Offset+opts.Limit, total)]
+	if len(hits) == 0 {
+		return nil, total, nil
+	}
+
+	args := make([]any, len(hits))
+	for i, h := range hits {
+		args[i] = h.ID
+	}
+	rows, err := r.db.QueryContext(ctx, "SELECT "+articleColumns+" FROM articles WHERE "+searchable+" AND id IN ("+
+		database.Placeholders(len(args))+")", args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	byID := map[int64]*Article{}
+	for rows.Next() {
+		a, err := scanArticle(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		byID[a.ID] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]SearchResult, 0, len(hits))
+	for _, h := range hits {
+		if a, ok := byID[h.ID]; ok {
+			results = append(results, SearchResult{Article: *a, Rank: h.Score, Snippet: search.Highlight(a.Description, opts.Query, snippetWords)})
+		}
+	}
+	return results, total, r.loadResultRelations(ctx, results)
+}
+
+// searchIndex: indeks guncel degilse articles tablosundan yeniden olusturur.
+func (r *sqlRepository) searchIndex(ctx context.Context) (*search.Index, error) {
+	r.memory.mu.Lock()
+	defer r.memory.mu.Unlock()
+
+	var (
+		count, versions int64
+		lastUpdate      sql.NullString
+	)
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*), COALESCE(SUM(version), 0), MAX(updated_at) FROM articles").
+		Scan(&count, &versions, &lastUpdate); err != nil {
+		return nil, err
+	}
+
+	stamp := fmt.Sprintf("%d/%d/%s", count, versions, lastUpdate.String)
+	if r.memory.index != nil && r.memory.stamp == stamp {
+		return r.memory.index, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT id, title, short_description, description FROM articles WHERE "+searchable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	index := search.NewIndex()
+	for rows.Next() {
+		var (
+			id                 int64
+			title, description string
+			short              sql.NullString
+		)
+		if err := rows.Scan(&id, &title, &short, &description); err != nil {
+			return nil, err
+		}
+		index.Put(id,
+			search.Field{Text: title, Weight: search.WeightA},
+			search.Field{Text: short.String, Weight: search.WeightB},
+			search.Field{Text: description, Weight: search.WeightC})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	r.memory.index, r.memory.stamp = index, stamp
+	return index, nil
+}
@@ -0,0 +1,47 @@
+package article
+
+import (
+	"slices"
+	"strings"
+
+	"feature-base-starter-kit/pkg/slug"
+)
+
+// maxTagSlug: tags.slug kolonunun uzunlugu (isim en fazla 50 karakter, bkz. CreateArticleRequest.Tags).
+const maxTagSlug = 60
+
+// tag: makaleye eklenecek etiket. Etiketin kimligi slug'dir, isim sadece etiket ilk kez olusturulurken kullanilir.
+type tag struct {
+	Name string
+	Slug string
+}
+
+// normalizeTags: etiket isimlerini slug'a gore tekillestirir ("Go", "go" ve " GO " tek etikettir), ilk gelen isim kullanilir.
+// Slug'i bos olan isimler atlanir (istekte "sluggable" kurali ile reddedilir).
+func normalizeTags(names []string) []tag {
+	tags := make([]tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		s := slug.Truncate(slug.Make(name), maxTagSlug)
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		tags = append(tags, tag{Name: name, Slug: s})
+	}
+	return tags
+}
+
+// sameTags: iki etiket listesi normalize edildikten sonra ayni etiketleri iceriyorsa true (sira ve yazim onemsiz).
+func sameTags(a, b []string) bool {
+	slugs := func(names []string) []string {
+		var s []string
+		for _, t := range normalizeTags(names) {
+			s = append(s, t.Slug)
+		}
+		slices.Sort(s)
+		return s
+	}
+	return slices.Equal(slugs(a), slugs(b))
+}
@@ -0,0 +1,131 @@
+package article
+
+import (
+	"reflect"
+	"slices"
+	"time"
+)
+
+type CreateArticleRequest struct {
+	Title             string              `json:"title" binding:"required,min=2,max=50"`
+	Slug              string              `json:"slug" binding:"required,slug,max=60" async:"unique=articles.slug"`
+	ShortDescription  *string             `json:"short_description" binding:"omitempty,max=150"`
+	Description       string              `json:"description" binding:"required"`
+	DescriptionFormat Format              `json:"description_format" binding:"omitempty,oneof=html markdown"` // verilmezse html, bkz. Format
+	IsActive          *bool               `json:"is_active"`                                                  // verilmezse true, false sadece editor
+	UserID            *int64              `json:"user_id" binding:"omitempty,min=1" async:"omitempty,exists=users.id"`
+	SEOSettings       *SEOSettingsRequest `json:"seo_settings"`
+	CategoryIDs       []int64             `json:"category_ids" binding:"omitempty,dive,min=1" async:"omitempty,dive,exists=categories.id"`
+	Tags              []string            `json:"tags" binding:"omitempty,max=20,dive,required,max=50,sluggable"` // olmayan etiketler olusturulur
+}
+
+// UpdateArticleRequest: kaydin tamamini degistirir (PUT). category_ids / tags verilirse iliskiler bu listeyle degistirilir.
+type UpdateArticleRequest struct {
+	Title             string              `json:"title" binding:"required,min=2,max=50"`
+	Slug              string              `json:"slug" binding:"required,slug,max=60"` // unique kontrolu veritabaninda
+	ShortDescription  *string             `json:"short_description" binding:"omitempty,max=150"`
+	Description       string              `json:"description" binding:"required"`
+	DescriptionFormat Format              `json:"description_format" binding:"omitempty,oneof=html markdown"` // verilmezse html
+	IsActive          *bool               `json:"is_active"`                                                  // verilmezse degismez, degistirmek editor gerektirir
+	UserID            *int64              `json:"user_id" binding:"omitempty,min=1" async:"omitempty,exists=users.id"`
+	SEOSettings       *SEOSettingsRequest `json:"seo_settings"`
+	CategoryIDs       []int64             `json:"category_ids" binding:"omitempty,dive,min=1" async:"omitempty,dive,exists=categories.id"`
+	Tags              []string            `json:"tags" binding:"omitempty,max=20,dive,required,max=50,sluggable"`
+}
+
+// TransitionArticleRequest: makalenin yayin durumunu degistirir (bkz. workflow.go). publish_at sadece scheduled icin kullanilir.
+type TransitionArticleRequest struct {
+	Status    Status     `json:"status" binding:"required,oneof=draft review scheduled published archived"`
+	PublishAt *time.Time `json:"publish_at" binding:"required_if=Status scheduled"` // RFC 3339, orn: 2026-01-02T09:00:00Z
+	Note      *string    `json:"note" binding:"omitempty,max=500"`
+}
+
+// ListArticlesRequest: GET /articles filtreleri (sayfalama icin bkz. api.PaginationFrom).
+type ListArticlesRequest struct {
+	CategoryID         int64  `form:"category_id" json:"category_id" binding:"omitempty,min=1"`
+	IncludeDescendants bool   `form:"include_descendants" json:"include_descendants"`
+	Tag                string `form:"tag" json:"tag" binding:"omitempty,slug,max=60"` // etiket slug'i
+}
+
+// SearchArticlesRequest: GET /articles/search query parametreleri (sayfalama icin bkz. api.PaginationFrom).
+type SearchArticlesRequest struct {
+	Q string `form:"q" json:"q" binding:"required,min=2,max=200"`
+}
+
+type SEOSettingsRequest struct {
+	MetaTitle       string   `json:"meta_title" binding:"omitempty,max=70"`
+	MetaDescription string   `json:"meta_description" binding:"omitempty,max=160"`
+	Keywords        []string `json:"keywords" binding:"omitempty,max=10,dive,required,max=50"`
+}
+
+func (r *SEOSettingsRequest) settings() *SEOSettings {
+	if r == nil {
+		return nil
+	}
+	return &SEOSettings{MetaTitle: r.MetaTitle, MetaDescription: r.MetaDescription, Keywords: r.Keywords}
+}
+
+// updateRequestFrom: PATCH'in uygulanacagi belge, kaydin PUT DTO'su seklindeki guncel hali.
+// Markdown makalelerde description, render edilmis HTML degil markdown kaynagidir.
+func updateRequestFrom(a *Article) UpdateArticleRequest {
+	isActive := a.IsActive
+	req := UpdateArticleRequest{
+		Title:             a.Title,
+		Slug:              a.Slug,
+		ShortDescription:  a.ShortDescription,
+		Description:       bodyOf(a).source(),
+		DescriptionFormat: a.DescriptionFormat,
+		IsActive:          &isActive,
+		UserID:            a.UserID,
+		CategoryIDs:       a.CategoryIDs,
+		Tags:              a.Tags,
+	}
+	if s := a.SEOSettings; s != nil {
+		req.SEOSettings = &SEOSettingsRequest{MetaTitle: s.MetaTitle, MetaDescription: s.MetaDescription, Keywords: s.Keywords}
+	}
+	return req
+}
+
+// changes: patch uygulanmis istegin a'dan farkli olan kolonlari (sadece bunlar yazilir). description kolonlari
+// istekten degil, istekteki description'dan uretilen body'den gelir (bkz. Handler.description).
+// category_ids ve tags kolon degil, degistiyse Repository.Patch iliskileri bu listeyle degistirir.
+func (req UpdateArticleRequest) changes(a *Article, body Body) map[string]any {
+	c := map[string]any{}
+	if req.Title != a.Title {
+		c["title"] = req.Title
+	}
+	if req.Slug != a.Slug {
+		c["slug"] = req.Slug
+	}
+	if !reflect.DeepEqual(req.ShortDescription, a.ShortDescription) {
+		c["short_description"] = req.ShortDescription
+	}
+	if body.HTML != a.Description {
+		c["description"] = body.HTML
+	}
+	if body.Format != a.DescriptionFormat {
+		c["description_format"] = body.Format
+	}
+	if !reflect.DeepEqual(body.Source, a.DescriptionSource) {
+		c["description_source"] = body.Source
+	}
+	if !reflect.DeepEqual(body.TOC, a.TOC) {
+		c["toc"] = body.TOC
+	}
+	if req.IsActive != nil && *req.IsActive != a.IsActive {
+		c["is_active"] = *req.IsActive
+	}
+	if !reflect.DeepEqual(req.UserID, a.UserID) {
+		c["user_id"] = req.UserID
+	}
+	if seo := req.SEOSettings.settings(); !reflect.DeepEqual(seo, a.SEOSettings) {
+		c["seo_settings"] = seoValue(seo)
+	}
+	if !slices.Equal(req.CategoryIDs, a.CategoryIDs) {
+		c["category_ids"] = append([]int64{}, req.CategoryIDs...) // null/remove: tum iliskiler silinir
+	}
+	if !sameTags(req.Tags, a.Tags) {
+		c["tags"] = append([]string{}, req.Tags...)
+	}
+	return c
+}
@@ -0,0 +1,106 @@
+package article
+
+import (
+	"errors"
+	"time"
+
+	"feature-base-starter-kit/pkg/auth"
+)
+
+// Status: makalenin yayin akisindaki durumu.
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusReview    Status = "review"
+	StatusScheduled Status = "scheduled" // published_at geldiginde Scheduler tarafindan yayinlanir
+	StatusPublished Status = "published"
+	StatusArchived  Status = "archived"
+)
+
+var (
+	// ErrInvalidTransition: mevcut durumdan istenen duruma gecis tanimli degil.
+	ErrInvalidTransition = errors.New("article: invalid status transition")
+	// ErrTransitionForbidden: gecis tanimli fakat istegi yapan rol yetkili degil (orn: author yayinlayamaz).
+	ErrTransitionForbidden = errors.New("article: role is not allowed to perform this transition")
+	// ErrEditForbidden: rol makaleyi mevcut durumunda degistiremez veya is_active'i degistirmeye yetkili degil, bkz. checkEdit.
+	ErrEditForbidden = errors.New("article: role is not allowed to edit this article")
+	// ErrPublishAtPast: scheduled icin verilen yayin zamani gecmiste.
+	ErrPublishAtPast = errors.New("article: publish_at must be in the future")
+)
+
+// transitions: mevcut durum -> hedef durum -> gecisi yapabilecek en dusuk rol.
+// Yazarlar sadece taslagi incelemeye gonderebilir veya geri cekebilir; yayinla ilgili tum gecisler editor gerektirir.
+var transitions = map[Status]map[Status]auth.Role{
+	StatusDraft:     {StatusReview: auth.RoleAuthor, StatusScheduled: auth.RoleEditor, StatusPublished: auth.RoleEditor},
+	StatusReview:    {StatusDraft: auth.RoleAuthor, StatusScheduled: auth.RoleEditor, StatusPublished: auth.RoleEditor},
+	StatusScheduled: {StatusDraft: auth.RoleEditor, StatusPublished: auth.RoleEditor},
+	StatusPublished: {StatusDraft: auth.RoleEditor, StatusArchived: auth.RoleEditor},
+	StatusArchived:  {StatusDraft: auth.RoleEditor, StatusPublished: auth.RoleEditor},
+}
+
+// editRoles: makaleyi degistirebilecek (PUT/PATCH), silebilecek veya geri alabilecek en dusuk rol, duruma gore.
+// Yazar incelemedeki veya yayindaki makaleyi degistiremez; once review -> draft gecisi ile geri cekmelidir.
+var editRoles = map[Status]auth.Role{
+	StatusDraft:     auth.RoleAuthor,
+	StatusReview:    auth.RoleEditor,
+	StatusScheduled: auth.RoleEditor,
+	StatusPublished: auth.RoleEditor,
+	StatusArchived:  auth.RoleEditor,
+}
+
+// checkEdit: PUT/PATCH/DELETE/restore icin newTransition'daki rol kurallarinin karsiligi. isActive istenen degerdir (nil: degismez).
+func checkEdit(a *Article, isActive *bool, role auth.Role) error {
+	minRole, ok := editRoles[a.Status]
+	if !ok {
+		minRole = auth.RoleEditor
+	}
+	if !role.Allows(minRole) {
+		return ErrEditForbidden
+	}
+	return checkActive(a.IsActive, isActive, role)
+}
+
+// checkActive: is_active yayin karari oldugundan sadece editor ve uzeri tarafindan degistirilebilir.
+func checkActive(current bool, requested *bool, role auth.Role) error {
+	if requested != nil && *requested != current && !role.Allows(auth.RoleEditor) {
+		return ErrEditForbidden
+	}
+	return nil
+}
+
+// Transition: Repository.Transition'a giden durum degisikligi, newTransition ile olusturulur.
+type Transition struct {
+	From        Status
+	To          Status
+	PublishedAt *time.Time // published_at kolonunun yeni degeri
+	Actor       auth.Role
+	Note        *string
+}
+
+// newTransition: gecisin tanimli oldugunu ve rolun yetkisini kontrol eder, published_at'in yeni degerini belirler:
+// published: simdi, scheduled: publishAt (gelecekte olmali), archived: degismez, draft/review: bosaltilir.
+func newTransition(a *Article, to Status, publishAt *time.Time, role auth.Role, note *string, now time.Time) (Transition, error) {
+	minRole, ok := transitions[a.Status][to]
+	if !ok {
+		return Transition{}, ErrInvalidTransition
+	}
+	if !role.Allows(minRole) {
+		return Transition{}, ErrTransitionForbidden
+	}
+
+	t := Transition{From: a.Status, To: to, Actor: role, Note: note}
+	switch to {
+	case StatusPublished:
+		t.PublishedAt = &now
+	case StatusScheduled:
+		if publishAt == nil || !publishAt.After(now) {
+			return Transition{}, ErrPublishAtPast
+		}
+		at := publishAt.UTC()
+		t.PublishedAt = &at
+	case StatusArchived:
+		t.PublishedAt = a.PublishedAt
+	}
+	return t, nil
+}
@@ -0,0 +1,147 @@
+package category
+
+import (
+	"errors"
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/validation"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler: category endpoint'leri.
+type Handler struct {
+	repo Repository
+}
+
+func NewHandler(repo Repository) *Handler {
+	return &Handler{repo: repo}
+}
+
+func (h *Handler) CreateCategoryHandler(c *gin.Context) {
+	var req CreateCategoryRequest
+	if !api.BindJSON(c, &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+
+	cat := &Category{Name: req.Name, Slug: req.Slug, ParentID: req.ParentID, IsActive: req.IsActive == nil || *req.IsActive}
+	if err := h.repo.Create(c.Request.Context(), cat); err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusCreated, "category.created", cat)
+}
+
+func (h *Handler) GetCategoryHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "category.not_found", nil, c.Param("id"))
+		return
+	}
+
+	cat, err := h.repo.GetByID(c.Request.Context(), id)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", cat)
+}
+
+func (h *Handler) ListCategoriesHandler(c *gin.Context) {
+	p := api.PaginationFrom(c)
+
+	categories, total, err := h.repo.List(c.Request.Context(), p.Limit(), p.Offset())
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", api.NewPage(categories, p, total))
+}
+
+// CategoryTreeHandler: tum kategoriler ic ice (children) agac olarak. ?active=true: sadece aktif kategoriler,
+// pasif bir kategorinin alt kategorileri de gosterilmez.
+func (h *Handler) CategoryTreeHandler(c *gin.Context) {
+	categories, err := h.repo.All(c.Request.Context())
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", BuildTree(categories, api.QueryBool(c, "active")))
+}
+
+// BreadcrumbHandler: slug'a gore kategorinin kokten kendisine kadar olan yolu (orn: Yazilim > Go > Test).
+func (h *Handler) BreadcrumbHandler(c *gin.Context) {
+	slug := c.Param("slug")
+
+	path, err := h.repo.Breadcrumb(c.Request.Context(), slug)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			api.SendError(c, http.StatusNotFound, "category.slug_not_found", nil, slug)
+			return
+		}
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", path)
+}
+
+func (h *Handler) UpdateCategoryHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "category.not_found", nil, c.Param("id"))
+		return
+	}
+
+	var req UpdateCategoryRequest
+	if !api.BindJSON(c, &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+
+	cat := &Category{ID: id, Name: req.Name, Slug: req.Slug, ParentID: req.ParentID, IsActive: *req.IsActive}
+	if err := h.repo.Update(c.Request.Context(), cat); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "category.updated", cat)
+}
+
+func (h *Handler) DeleteCategoryHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "category.not_found", nil, c.Param("id"))
+		return
+	}
+
+	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "category.deleted", nil)
+}
+
+func sendRepositoryError(c *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		api.SendError(c, http.StatusNotFound, "category.not_found", nil, id)
+	case errors.Is(err, ErrSlugTaken):
+		api.SendError(c, http.StatusConflict, "category.slug_taken", nil)
+	case errors.Is(err, ErrParentCycle):
+		loc := i18n.FromContext(c)
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("parent_id"): {loc.T("category.parent_cycle")},
+		})
+	case errors.Is(err, ErrInvalidParent):
+		api.SendError(c, http.StatusUnprocessableEntity, "category.invalid_parent", nil)
+	default:
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
+}
@@ -0,0 +1,14 @@
+package category
+
+import "time"
+
+// Category: categories tablosundaki bir kayit. Kategoriler parent_id ile agac olusturur (bkz. tree.go).
+type Category struct {
+	ID        int64     `json:"id" xml:"id" yaml:"id"`
+	Name      string    `json:"name" xml:"name" yaml:"name"`
+	Slug      string    `json:"slug" xml:"slug" yaml:"slug"`
+	ParentID  *int64    `json:"parent_id" xml:"parent_id" yaml:"parent_id"` // nil ise kok kategori
+	IsActive  bool      `json:"is_active" xml:"is_active" yaml:"is_active"`
+	CreatedAt time.Time `json:"created_at" xml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" xml:"updated_at" yaml:"updated_at"`
+}
@@ -0,0 +1,239 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+var (
+	// ErrSlugTaken: slug alaninda unique ihlali.
+	ErrSlugTaken = errors.New("category: slug already taken")
+	// ErrParentCycle: kategori kendi altina veya alt kategorilerinden birinin altina tasinmak isteniyor.
+	ErrParentCycle = errors.New("category: parent would create a cycle")
+	// ErrInvalidParent: parent_id var olmayan bir kategoriye isaret ediyor (foreign key ihlali).
+	ErrInvalidParent = errors.New("category: parent does not exist")
+)
+
+// Repository: category modulunun veritabani islemleri. Bulunamayan kayitlar icin database.ErrNotFound doner.
+// Agac sorgulari (ata/alt kategoriler) WITH RECURSIVE ile yapilir; postgres, mysql 8 ve sqlite ayni sozdizimini destekler.
+type Repository interface {
+	Create(ctx context.Context, c *Category) error
+	// Upsert: slug'a gore ekler veya gunceller (seed ve import islemleri icin).
+	Upsert(ctx context.Context, c *Category) error
+	GetByID(ctx context.Context, id int64) (*Category, error)
+	List(ctx context.Context, limit, offset int) ([]Category, int, error)
+	// Update: c.ParentID kategorinin kendisi veya alt kategorilerinden biriyse ErrParentCycle doner.
+	Update(ctx context.Context, c *Category) error
+	// Delete: alt kategoriler koke tasinir (ON DELETE SET NULL).
+	Delete(ctx context.Context, id int64) error
+	// All: tum kategoriler, isme gore sirali (agac icin, bkz. BuildTree).
+	All(ctx context.Context) ([]Category, error)
+	// Breadcrumb: slug'a gore kategori ve atalari, kokten kategoriye dogru. Kategori yoksa database.ErrNotFound.
+	Breadcrumb(ctx context.Context, slug string) ([]Category, error)
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const categoryColumns = "id, name, slug, parent_id, is_active, created_at, updated_at"
+
+// maxDepth: ata zincirini izleyen sorgularda ust sinir; veride dongu olussa bile sorgunun sonlanmasini garanti eder.
+const maxDepth = 100
+
+func scanCategory(row interface{ Scan(...any) error }) (*Category, error) {
+	var (
+		c        Category
+		parentID sql.NullInt64
+	)
+	if err := row.Scan(&c.ID, &c.Name, &c.Slug, &parentID, &c.IsActive, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if parentID.Valid {
+		c.ParentID = &parentID.Int64
+	}
+	return &c, nil
+}
+
+func (r *sqlRepository) Create(ctx context.Context, c *Category) error {
+	id, err := r.db.Insert(ctx, "INSERT INTO categories (name, slug, parent_id, is_active) VALUES (?, ?, ?, ?)",
+		c.Name, c.Slug, c.ParentID, c.IsActive)
+	if err != nil {
+		return r.mapError(err)
+	}
+
+	return r.reload(ctx, id, c)
+}
+
+func (r *sqlRepository) Upsert(ctx context.Context, c *Category) error {
+	q := r.db.Dialect.Upsert("categories", []string{"name", "slug", "is_active"}, []string{"slug"}, []string{"name", "is_active"})
+	if _, err := r.db.ExecContext(ctx, q, c.Name, c.Slug, c.IsActive); err != nil {
+		return err
+	}
+
+	// upsert'te LastInsertId/RETURNING dialect'ler arasinda tutarli degil, kayit slug ile okunur
+	fresh, err := scanCategory(r.db.QueryRowContext(ctx, "SELECT "+categoryColumns+" FROM categories WHERE slug = ?", c.Slug))
+	if err != nil {
+		return err
+	}
+	*c = *fresh
+	return nil
+}
+
+func (r *sqlRepository) GetByID(ctx context.Context, id int64) (*Category, error) {
+	c, err := scanCategory(r.db.QueryRowContext(ctx, "SELECT "+categoryColumns+" FROM categories WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	return c, err
+}
+
+func (r *sqlRepository) List(ctx context.Context, limit, offset int) ([]Category, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM categories").Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+categoryColumns+" FROM categories ORDER BY id LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var categories []Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		categories = append(categories, *c)
+	}
+
+	return categories, total, rows.Err()
+}
+
+// Update: dongu kontrolu ile guncelleme ayri sorgulardir; ayni anda iki kategoriyi birbirinin altina tasiyan
+// istekler nadir oldugu icin kilit kullanilmaz, agac sorgulari maxDepth ile yine de sonlanir.
+func (r *sqlRepository) Update(ctx context.Context, c *Category) error {
+	if c.ParentID != nil {
+		if err := r.checkParent(ctx, c.ID, *c.ParentID); err != nil {
+			return err
+		}
+	}
+
+	_, err := r.db.ExecContext(ctx, "UPDATE categories SET name = ?, slug = ?, parent_id = ?, is_active = ? WHERE id = ?",
+		c.Name, c.Slug, c.ParentID, c.IsActive, c.ID)
+	if err != nil {
+		return r.mapError(err)
+	}
+
+	return r.reload(ctx, c.ID, c)
+}
+
+func (r *sqlRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM categories WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return database.ErrNotFound
+	}
+	return nil
+}
+
+// checkParent: parentID, id'nin kendisi veya atalari arasinda id varsa (yani parentID id'nin alt kategorisiyse) ErrParentCycle.
+func (r *sqlRepository) checkParent(ctx context.Context, id, parentID int64) error {
+	if parentID == id {
+		return ErrParentCycle
+	}
+
+	var n int
+	err := r.db.QueryRowContext(ctx, `WITH RECURSIVE ancestors (id, parent_id, depth) AS (
+			SELECT id, parent_id, 0 FROM categories WHERE id = ?
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1 FROM categories c JOIN ancestors a ON c.id = a.parent_id WHERE a.depth < ?
+		)
+		SELECT COUNT(*) FROM ancestors WHERE id = ?`, parentID, maxDepth, id).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return ErrParentCycle
+	}
+	return nil
+}
+
+func (r *sqlRepository) All(ctx context.Context) ([]Category, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+categoryColumns+" FROM categories ORDER BY name, id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, *c)
+	}
+	return categories, rows.Err()
+}
+
+func (r *sqlRepository) Breadcrumb(ctx context.Context, slug string) ([]Category, error) {
+	rows, err := r.db.QueryContext(ctx, `WITH RECURSIVE ancestors (id, parent_id, depth) AS (
+			SELECT id, parent_id, 0 FROM categories WHERE slug = ?
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1 FROM categories c JOIN ancestors a ON c.id = a.parent_id WHERE a.depth < ?
+		)
+		SELECT c.id, c.name, c.slug, c.parent_id, c.is_active, c.created_at, c.updated_at
+		FROM categories c JOIN ancestors a ON a.id = c.id ORDER BY a.depth DESC`, slug, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var path []Category
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, *c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, database.ErrNotFound
+	}
+	return path, nil
+}
+
+func (r *sqlRepository) reload(ctx context.Context, id int64, c *Category) error {
+	fresh, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	*c = *fresh
+	return nil
+}
+
+func (r *sqlRepository) mapError(err error) error {
+	switch {
+	case r.db.Dialect.IsUniqueViolation(err):
+		return ErrSlugTaken
+	case r.db.Dialect.IsForeignKeyViolation(err):
+		return ErrInvalidParent
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package category
+
+// Node: kategori agacindaki bir dugum. Children isme gore siralidir, yaprak dugumlerde bos listedir.
+type Node struct {
+	Category `yaml:",inline"`
+	Children []*Node `json:"children" xml:"children>category" yaml:"children"`
+}
+
+// BuildTree: duz kategori listesinden (bkz. Repository.All) kok dugumleri olusturur, siralama korunur.
+// Ust kategorisi listede olmayan kategoriler (orn: activeOnly ile elenen pasif bir kategorinin altindakiler)
+// activeOnly ise agaca alinmaz, degilse koke eklenir.
+func BuildTree(categories []Category, activeOnly bool) []*Node {
+	nodes := make(map[int64]*Node, len(categories))
+	for _, c := range categories {
+		if activeOnly && !c.IsActive {
+			continue
+		}
+		nodes[c.ID] = &Node{Category: c, Children: []*Node{}}
+	}
+
+	roots := []*Node{}
+	for _, c := range categories {
+		node, ok := nodes[c.ID]
+		if !ok {
+			continue
+		}
+
+		if c.ParentID != nil {
+			if parent, ok := nodes[*c.ParentID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+			if activeOnly {
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
@@ -0,0 +1,16 @@
+package category
+
+type CreateCategoryRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=50"`
+	Slug     string `json:"slug" binding:"required,slug,max=60" async:"unique=categories.slug"`
+	ParentID *int64 `json:"parent_id" binding:"omitempty,min=1" async:"omitempty,exists=categories.id"` // verilmezse kok kategori
+	IsActive *bool  `json:"is_active"`                                                                  // verilmezse true
+}
+
+// UpdateCategoryRequest: kaydin tamamini degistirir (PUT).
+type UpdateCategoryRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=50"`
+	Slug     string `json:"slug" binding:"required,slug,max=60"`                                        // unique kontrolu veritabaninda (bkz. user.UpdateUserRequest)
+	ParentID *int64 `json:"parent_id" binding:"omitempty,min=1" async:"omitempty,exists=categories.id"` // verilmezse koke tasinir
+	IsActive *bool  `json:"is_active" binding:"required"`
+}
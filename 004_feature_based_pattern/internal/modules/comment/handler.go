@@ -0,0 +1,205 @@
+package comment
+
+import (
+	"errors"
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/ratelimit"
+	"feature-base-starter-kit/pkg/validation"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Options: yorum olusturma kurallari (bkz. config.CommentConfig).
+type Options struct {
+	AutoApprove bool
+	BannedWords []string
+	MaxLinks    int
+	IPRateLimit int
+	RateWindow  time.Duration
+}
+
+// Handler: yorum endpoint'leri. Okuyucu endpoint'leri sadece yayinlanmis makalelerde calisir; moderasyon
+// endpoint'leri (/comments) router'da admin rolu ile sinirlandirilir.
+type Handler struct {
+	repo        Repository
+	spam        *SpamScorer
+	autoApprove bool
+	ipLimit     *ratelimit.Limiter
+}
+
+func NewHandler(repo Repository, opts Options) *Handler {
+	return &Handler{
+		repo:        repo,
+		spam:        NewSpamScorer(opts.BannedWords, opts.MaxLinks),
+		autoApprove: opts.AutoApprove,
+		ipLimit:     ratelimit.New(opts.IPRateLimit, opts.RateWindow),
+	}
+}
+
+// ListArticleCommentsHandler: GET /articles/:id/comments, onayli yorumlar thread olarak (replies).
+func (h *Handler) ListArticleCommentsHandler(c *gin.Context) {
+	articleID, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	comments, err := h.repo.Approved(c.Request.Context(), articleID)
+	if err != nil {
+		sendRepositoryError(c, err, articleID)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", BuildThread(comments))
+}
+
+// CreateCommentHandler: POST /articles/:id/comments. Yorum spam puanina gore spam, AutoApprove aciksa approved,
+// degilse pending olarak kaydedilir. IP basina hiz siniri asilirsa 429 ve Retry-After gonderilir.
+func (h *Handler) CreateCommentHandler(c *gin.Context) {
+	articleID, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, c.Param("id"))
+		return
+	}
+
+	var req CreateCommentRequest
+	if !api.BindJSON(c, &req) || !api.ValidateAsync(c, &req) {
+		return
+	}
+	if !h.allow(c) {
+		return
+	}
+
+	cm := &Comment{
+		ArticleID:   articleID,
+		ParentID:    req.ParentID,
+		AuthorName:  strings.TrimSpace(req.AuthorName),
+		AuthorEmail: req.AuthorEmail,
+		Body:        req.Body,
+		IP:          c.ClientIP(),
+	}
+	cm.SpamScore = h.spam.Score(cm.AuthorName, cm.Body)
+	switch {
+	case cm.SpamScore >= spamThreshold:
+		cm.Status = StatusSpam
+	case h.autoApprove:
+		cm.Status = StatusApproved
+	default:
+		cm.Status = StatusPending
+	}
+
+	if err := h.repo.Create(c.Request.Context(), cm); err != nil {
+		sendRepositoryError(c, err, articleID)
+		return
+	}
+
+	// spam olarak isaretlenen yorum da pending gibi bildirilir, spam gonderene ipucu verilmez
+	key := "comment.pending"
+	if cm.Status == StatusApproved {
+		key = "comment.created"
+	}
+	view := cm.public()
+	if cm.Status == StatusSpam {
+		view.Status, view.SpamScore = StatusPending, 0
+	}
+	api.SendSuccess(c, http.StatusCreated, key, view)
+}
+
+// ModerationQueueHandler: GET /comments?status=pending|approved|spam&article_id= (admin).
+func (h *Handler) ModerationQueueHandler(c *gin.Context) {
+	var req ListCommentsRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+	if req.Status == "" {
+		req.Status = StatusPending
+	}
+
+	p := api.PaginationFrom(c)
+	comments, total, err := h.repo.List(c.Request.Context(), req.Status, req.ArticleID, p.Limit(), p.Offset())
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", api.NewPage(comments, p, total))
+}
+
+// ApproveCommentHandler: POST /comments/:id/approve (admin).
+func (h *Handler) ApproveCommentHandler(c *gin.Context) {
+	h.setStatus(c, StatusApproved, "comment.approved")
+}
+
+// SpamCommentHandler: POST /comments/:id/spam (admin). Yorumun cevaplari okuyuculara gosterilmez (bkz. BuildThread).
+func (h *Handler) SpamCommentHandler(c *gin.Context) {
+	h.setStatus(c, StatusSpam, "comment.marked_spam")
+}
+
+// DeleteCommentHandler: DELETE /comments/:id (admin), yorum cevaplariyla birlikte kalici olarak silinir.
+func (h *Handler) DeleteCommentHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "comment.not_found", nil, c.Param("id"))
+		return
+	}
+
+	if err := h.repo.Delete(c.Request.Context(), id); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "comment.deleted", nil)
+}
+
+func (h *Handler) setStatus(c *gin.Context, status Status, key string) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "comment.not_found", nil, c.Param("id"))
+		return
+	}
+
+	cm, err := h.repo.SetStatus(c.Request.Context(), id, status)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, key, cm)
+}
+
+// allow: IP hiz sinirini kontrol eder; asilmissa 429 gonderir ve false doner. API anahtarlari kullaniciya degil role
+// bagli oldugundan istemcinin beyan ettigi bir kimlige gore sinir uygulanmaz.
+func (h *Handler) allow(c *gin.Context) bool {
+	ok, retry := h.ipLimit.Allow("ip:" + c.ClientIP())
+	if ok {
+		return true
+	}
+
+	seconds := int(math.Ceil(retry.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	api.SendError(c, http.StatusTooManyRequests, "request.rate_limited", nil, seconds)
+	return false
+}
+
+func sendRepositoryError(c *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		api.SendError(c, http.StatusNotFound, "comment.not_found", nil, id)
+	case errors.Is(err, ErrArticleNotFound):
+		api.SendError(c, http.StatusNotFound, "article.not_found", nil, id)
+	case errors.Is(err, ErrInvalidParent):
+		loc := i18n.FromContext(c)
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("parent_id"): {loc.T("comment.invalid_parent")},
+		})
+	default:
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
+}
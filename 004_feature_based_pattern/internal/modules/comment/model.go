@@ -0,0 +1,63 @@
+package comment
+
+import "time"
+
+// Status: yorumun moderasyon durumu. Sadece approved yorumlar okuyuculara gosterilir.
+type Status string
+
+const (
+	StatusPending  Status = "pending" // moderasyon kuyrugunda
+	StatusApproved Status = "approved"
+	StatusSpam     Status = "spam"
+)
+
+// Comment: comments tablosundaki bir kayit. AuthorEmail ve IP sadece moderasyon endpoint'lerinde gonderilir (bkz. public).
+// comments.user_id kullanici bazli kimlik dogrulama eklendiginde doldurulmak uzere bos birakilir.
+type Comment struct {
+	ID          int64     `json:"id" xml:"id" yaml:"id"`
+	ArticleID   int64     `json:"article_id" xml:"article_id" yaml:"article_id"`
+	ParentID    *int64    `json:"parent_id" xml:"parent_id" yaml:"parent_id"` // nil ise makaleye dogrudan yorum
+	AuthorName  string    `json:"author_name" xml:"author_name" yaml:"author_name"`
+	AuthorEmail *string   `json:"author_email,omitempty" xml:"author_email,omitempty" yaml:"author_email,omitempty"`
+	Body        string    `json:"body" xml:"body" yaml:"body"` // duz metin, HTML olarak yorumlanmaz
+	Status      Status    `json:"status" xml:"status" yaml:"status"`
+	SpamScore   float64   `json:"spam_score" xml:"spam_score" yaml:"spam_score"` // bkz. SpamScorer
+	IP          string    `json:"ip,omitempty" xml:"ip,omitempty" yaml:"ip,omitempty"`
+	CreatedAt   time.Time `json:"created_at" xml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" xml:"updated_at" yaml:"updated_at"`
+}
+
+// public: okuyuculara gonderilen hali, e-posta ve IP cikarilir.
+func (c Comment) public() Comment {
+	c.AuthorEmail = nil
+	c.IP = ""
+	return c
+}
+
+// Node: yorum agacindaki bir dugum (thread). Replies eskiden yeniye siralidir.
+type Node struct {
+	Comment `yaml:",inline"`
+	Replies []*Node `json:"replies" xml:"replies>comment" yaml:"replies"`
+}
+
+// BuildThread: eskiden yeniye sirali duz listeden kok yorumlari olusturur. Ust yorumu listede olmayan cevaplar
+// (orn: ust yorum sonradan spam olarak isaretlenmis) gosterilmez.
+func BuildThread(comments []Comment) []*Node {
+	nodes := make(map[int64]*Node, len(comments))
+	for _, c := range comments {
+		nodes[c.ID] = &Node{Comment: c.public(), Replies: []*Node{}}
+	}
+
+	roots := []*Node{}
+	for _, c := range comments {
+		node := nodes[c.ID]
+		if c.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[*c.ParentID]; ok {
+			parent.Replies = append(parent.Replies, node)
+		}
+	}
+	return roots
+}
@@ -0,0 +1,179 @@
+package comment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+var (
+	// ErrArticleNotFound: makale yok, silinmis veya yayinlanmamis; yorum yapilamaz ve yorumlari gosterilmez.
+	ErrArticleNotFound = errors.New("comment: article not found")
+	// ErrInvalidParent: parent_id ayni makaledeki onayli bir yoruma isaret etmiyor.
+	ErrInvalidParent = errors.New("comment: parent comment is not an approved comment of the article")
+)
+
+// Repository: comment modulunun veritabani islemleri. Bulunamayan yorumlar icin database.ErrNotFound doner.
+type Repository interface {
+	// Create: yorumu ekler. Makale yayinlanmamissa ErrArticleNotFound, parent gecersizse ErrInvalidParent doner.
+	Create(ctx context.Context, c *Comment) error
+	GetByID(ctx context.Context, id int64) (*Comment, error)
+	// Approved: makalenin onayli yorumlari, eskiden yeniye (bkz. BuildThread).
+	Approved(ctx context.Context, articleID int64) ([]Comment, error)
+	// List: moderasyon kuyrugu, status'teki yorumlar eskiden yeniye; articleID > 0 ise sadece o makaledekiler.
+	List(ctx context.Context, status Status, articleID int64, limit, offset int) ([]Comment, int, error)
+	SetStatus(ctx context.Context, id int64, status Status) (*Comment, error)
+	// Delete: yorumu ve tum cevaplarini kalici olarak siler (ON DELETE CASCADE).
+	Delete(ctx context.Context, id int64) error
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const commentColumns = "id, article_id, parent_id, author_name, author_email, body, status, spam_score, ip, created_at, updated_at"
+
+// publishedArticle: okuyucularin gorebildigi makale (bkz. feed.published).
+const publishedArticle = "SELECT 1 FROM articles WHERE id = ? AND status = 'published' AND is_active AND deleted_at IS NULL"
+
+func scanComment(row interface{ Scan(...any) error }) (*Comment, error) {
+	var (
+		c        Comment
+		parentID sql.NullInt64
+		email    sql.NullString
+	)
+	if err := row.Scan(&c.ID, &c.ArticleID, &parentID, &c.AuthorName, &email, &c.Body, &c.Status, &c.SpamScore, &c.IP,
+		&c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	if parentID.Valid {
+		c.ParentID = &parentID.Int64
+	}
+	if email.Valid {
+		c.AuthorEmail = &email.String
+	}
+	return &c, nil
+}
+
+func (r *sqlRepository) Create(ctx context.Context, c *Comment) error {
+	if err := r.checkArticle(ctx, c.ArticleID); err != nil {
+		return err
+	}
+
+	if c.ParentID != nil {
+		var one int
+		err := r.db.QueryRowContext(ctx, "SELECT 1 FROM comments WHERE id = ? AND article_id = ? AND status = ?",
+			*c.ParentID, c.ArticleID, StatusApproved).Scan(&one)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvalidParent
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	id, err := r.db.Insert(ctx, `INSERT INTO comments (article_id, parent_id, author_name, author_email, body, status, spam_score, ip)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		c.ArticleID, c.ParentID, c.AuthorName, c.AuthorEmail, c.Body, c.Status, c.SpamScore, c.IP)
+	if err != nil {
+		return err
+	}
+
+	fresh, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	*c = *fresh
+	return nil
+}
+
+func (r *sqlRepository) GetByID(ctx context.Context, id int64) (*Comment, error) {
+	c, err := scanComment(r.db.QueryRowContext(ctx, "SELECT "+commentColumns+" FROM comments WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	return c, err
+}
+
+func (r *sqlRepository) Approved(ctx context.Context, articleID int64) ([]Comment, error) {
+	if err := r.checkArticle(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	return r.query(ctx, "SELECT "+commentColumns+" FROM comments WHERE article_id = ? AND status = ? ORDER BY created_at, id",
+		articleID, StatusApproved)
+}
+
+func (r *sqlRepository) List(ctx context.Context, status Status, articleID int64, limit, offset int) ([]Comment, int, error) {
+	where := " WHERE status = ?"
+	args := []any{status}
+	if articleID > 0 {
+		where += " AND article_id = ?"
+		args = append(args, articleID)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM comments"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	comments, err := r.query(ctx, "SELECT "+commentColumns+" FROM comments"+where+" ORDER BY created_at, id LIMIT ? OFFSET ?",
+		append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return comments, total, nil
+}
+
+func (r *sqlRepository) SetStatus(ctx context.Context, id int64, status Status) (*Comment, error) {
+	// RowsAffected kullanilmaz: mysql degeri degismeyen satirlari etkilenmis saymaz, kaydin varligi GetByID ile kontrol edilir
+	if _, err := r.db.ExecContext(ctx, "UPDATE comments SET status = ? WHERE id = ?", status, id); err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+func (r *sqlRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM comments WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return database.ErrNotFound
+	}
+	return err
+}
+
+func (r *sqlRepository) checkArticle(ctx context.Context, articleID int64) error {
+	var one int
+	err := r.db.QueryRowContext(ctx, publishedArticle, articleID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrArticleNotFound
+	}
+	return err
+}
+
+func (r *sqlRepository) query(ctx context.Context, q string, args ...any) ([]Comment, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := []Comment{}
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, *c)
+	}
+	return comments, rows.Err()
+}
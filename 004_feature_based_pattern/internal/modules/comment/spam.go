@@ -0,0 +1,98 @@
+package comment
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// spamThreshold: bu puana ulasan yorumlar spam olarak kaydedilir.
+const spamThreshold = 1.0
+
+var linkRegex = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.`)
+
+// SpamScorer: basit sezgisel spam puani. Yasakli kelime veya MaxLinks'ten fazla link tek basina yorumu spam yapar;
+// linkler, buyuk harfle yazilmis metin ve tekrar eden karakterler puani arttirir.
+type SpamScorer struct {
+	bannedWords []string
+	maxLinks    int
+}
+
+// NewSpamScorer: bannedWords buyuk/kucuk harf duyarsizdir, kelime siniriyla eslesir ("sale" "wholesale"i yakalamaz).
+func NewSpamScorer(bannedWords []string, maxLinks int) *SpamScorer {
+	s := &SpamScorer{maxLinks: maxLinks}
+	for _, w := range bannedWords {
+		if w = normalize(w); w != "" {
+			s.bannedWords = append(s.bannedWords, w)
+		}
+	}
+	return s
+}
+
+// Score: yorumun spam puani, 0 temiz, spamThreshold ve ustu spam.
+func (s *SpamScorer) Score(authorName, body string) float64 {
+	score := 0.0
+
+	text := " " + normalize(authorName+" "+body) + " "
+	for _, w := range s.bannedWords {
+		if strings.Contains(text, " "+w+" ") {
+			score += spamThreshold
+		}
+	}
+
+	links := len(linkRegex.FindAllStringIndex(body, -1))
+	if links > s.maxLinks {
+		score += spamThreshold
+	} else {
+		score += 0.25 * float64(links)
+	}
+	if linkRegex.MatchString(authorName) {
+		score += 0.5
+	}
+
+	if shouting(body) {
+		score += 0.3
+	}
+	if repeats(body, 6) {
+		score += 0.2
+	}
+
+	return score
+}
+
+// normalize: kucuk harfe cevirir, harf/rakam disindaki karakterleri tek bosluga indirir.
+func normalize(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// shouting: en az 20 harfin %70'inden fazlasi buyuk harf.
+func shouting(s string) bool {
+	letters, upper := 0, 0
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	return letters >= 20 && float64(upper)/float64(letters) > 0.7
+}
+
+// repeats: ayni karakter art arda en az n kez tekrar ediyor mu (orn: "!!!!!!", "cooooool").
+func repeats(s string, n int) bool {
+	run, prev := 0, rune(-1)
+	for _, r := range s {
+		if r == prev && !unicode.IsSpace(r) {
+			run++
+			if run >= n {
+				return true
+			}
+			continue
+		}
+		run, prev = 1, r
+	}
+	return false
+}
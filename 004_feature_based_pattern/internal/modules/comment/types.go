@@ -0,0 +1,16 @@
+package comment
+
+// CreateCommentRequest: POST /articles/:id/comments. API anahtarlari kullaniciya degil role baglidir, bu yuzden
+// yorum bir kullanici hesabina baglanmaz; author_name istemcinin beyanidir.
+type CreateCommentRequest struct {
+	ParentID    *int64  `json:"parent_id" binding:"omitempty,min=1"` // ayni makaledeki onayli bir yoruma cevap
+	AuthorName  string  `json:"author_name" binding:"required,max=100"`
+	AuthorEmail *string `json:"author_email" binding:"omitempty,email,max=100"`
+	Body        string  `json:"body" binding:"required,min=2,max=5000"`
+}
+
+// ListCommentsRequest: GET /comments (moderasyon kuyrugu) filtreleri, sayfalama icin bkz. api.PaginationFrom.
+type ListCommentsRequest struct {
+	Status    Status `form:"status" json:"status" binding:"omitempty,oneof=pending approved spam"` // verilmezse pending
+	ArticleID int64  `form:"article_id" json:"article_id" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,249 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/database"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sitemapLimit: bir sitemap dosyasindaki en fazla URL sayisi (sitemaps.org).
+const sitemapLimit = 50000
+
+// Site: linklerin ve feed basliklarinin uretildigi site bilgileri (bkz. config.SiteConfig).
+type Site struct {
+	URL          string
+	Title        string
+	Description  string
+	Lang         string
+	ArticlePath  string // {slug} makalenin slug'i ile degistirilir
+	CategoryPath string
+	FeedLimit    int
+	CacheMaxAge  time.Duration
+}
+
+// Handler: RSS/Atom feed'leri ve sitemap.xml. Endpoint'ler herkese aciktir ve gin'in XML render'i ile yazilir.
+// Tum cevaplarda Cache-Control ve articles/categories updated_at'inden Last-Modified gonderilir (If-Modified-Since: 304).
+type Handler struct {
+	repo Repository
+	site Site
+}
+
+func NewHandler(repo Repository, site Site) *Handler {
+	site.URL = strings.TrimSuffix(site.URL, "/")
+	return &Handler{repo: repo, site: site}
+}
+
+// RSSHandler: GET /feed.xml ve GET /categories/:slug/feed.xml
+func (h *Handler) RSSHandler(c *gin.Context) {
+	category, entries, lastModified, ok := h.load(c)
+	if !ok {
+		return
+	}
+
+	channel := rssChannel{
+		Title:       h.title(category),
+		Link:        h.site.URL,
+		Description: h.site.Description,
+		Language:    h.site.Lang,
+		Self:        atomLink{Href: h.site.URL + c.Request.URL.Path, Rel: "self", Type: "application/rss+xml"},
+	}
+	if category != nil {
+		channel.Link = h.categoryURL(category.Slug)
+	}
+	if channel.Description == "" {
+		channel.Description = channel.Title // RSS'te description zorunludur
+	}
+	if !lastModified.IsZero() {
+		channel.LastBuildDate = lastModified.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, e := range entries {
+		link := h.articleURL(e.Slug)
+		item := rssItem{
+			Title:   e.Title,
+			Link:    link,
+			GUID:    rssGUID{IsPermaLink: true, Value: link},
+			PubDate: e.PublishedAt.UTC().Format(time.RFC1123Z),
+			Content: e.Content,
+		}
+		if e.Summary != nil {
+			item.Description = *e.Summary
+		}
+		for _, cat := range e.Categories {
+			item.Categories = append(item.Categories, cat.Name)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+
+	h.render(c, "application/rss+xml; charset=utf-8", rss{
+		Version:   "2.0",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		Channel:   channel,
+	})
+}
+
+// AtomHandler: GET /atom.xml ve GET /categories/:slug/atom.xml
+func (h *Handler) AtomHandler(c *gin.Context) {
+	category, entries, lastModified, ok := h.load(c)
+	if !ok {
+		return
+	}
+
+	alternate := h.site.URL
+	if category != nil {
+		alternate = h.categoryURL(category.Slug)
+	}
+	if lastModified.IsZero() {
+		lastModified = time.Now() // updated zorunludur
+	}
+
+	feed := atomFeed{
+		Title:    h.title(category),
+		Subtitle: h.site.Description,
+		ID:       h.site.URL + c.Request.URL.Path,
+		Updated:  lastModified.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: h.site.URL + c.Request.URL.Path, Rel: "self", Type: "application/atom+xml"},
+			{Href: alternate, Rel: "alternate", Type: "text/html"},
+		},
+		Author: atomPerson{Name: h.site.Title}, // yazari olmayan girdiler icin
+	}
+
+	for _, e := range entries {
+		link := h.articleURL(e.Slug)
+		entry := atomEntry{
+			Title:     e.Title,
+			ID:        link,
+			Link:      atomLink{Href: link, Rel: "alternate", Type: "text/html"},
+			Published: e.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:   e.UpdatedAt.UTC().Format(time.RFC3339),
+			Content:   atomText{Type: "html", Body: e.Content},
+		}
+		if e.Author != nil {
+			entry.Author = &atomPerson{Name: *e.Author}
+		}
+		if e.Summary != nil {
+			entry.Summary = &atomText{Type: "text", Body: *e.Summary}
+		}
+		for _, cat := range e.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: cat.Slug, Label: cat.Name})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	h.render(c, "application/atom+xml; charset=utf-8", feed)
+}
+
+// SitemapHandler: GET /sitemap.xml, aktif kategoriler ve yayinlanmis makaleler (toplam en fazla 50.000 URL).
+func (h *Handler) SitemapHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	lastModified, err := h.repo.LastModified(ctx)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+	h.cacheControl(c)
+	if api.NotModifiedSince(c, lastModified) {
+		return
+	}
+
+	categories, err := h.repo.Categories(ctx)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+	pages, err := h.repo.Pages(ctx, max(sitemapLimit-len(categories), 0))
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+
+	set := urlSet{URLs: make([]sitemapURL, 0, len(categories)+len(pages))}
+	for _, cat := range categories {
+		set.URLs = append(set.URLs, sitemapURL{Loc: h.categoryURL(cat.Slug), LastMod: cat.UpdatedAt.UTC().Format(time.RFC3339)})
+	}
+	for _, p := range pages {
+		set.URLs = append(set.URLs, sitemapURL{Loc: h.articleURL(p.Slug), LastMod: p.UpdatedAt.UTC().Format(time.RFC3339)})
+	}
+
+	h.render(c, "application/xml; charset=utf-8", set)
+}
+
+// load: feed'in kategorisini (:slug varsa), girdilerini ve Last-Modified degerini okur.
+// Kategori yoksa 404, If-Modified-Since eslesirse 304 gonderir ve false doner.
+func (h *Handler) load(c *gin.Context) (*Category, []Entry, time.Time, bool) {
+	ctx := c.Request.Context()
+
+	var category *Category
+	if slug := c.Param("slug"); slug != "" {
+		var err error
+		if category, err = h.repo.Category(ctx, slug); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				api.SendError(c, http.StatusNotFound, "category.slug_not_found", nil, slug)
+			} else {
+				api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+			}
+			return nil, nil, time.Time{}, false
+		}
+	}
+
+	lastModified, err := h.repo.LastModified(ctx)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return nil, nil, time.Time{}, false
+	}
+	h.cacheControl(c)
+	if api.NotModifiedSince(c, lastModified) {
+		return nil, nil, time.Time{}, false
+	}
+
+	var categoryID int64
+	if category != nil {
+		categoryID = category.ID
+	}
+	entries, err := h.repo.Entries(ctx, categoryID, h.site.FeedLimit)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return nil, nil, time.Time{}, false
+	}
+
+	return category, entries, lastModified, true
+}
+
+func (h *Handler) cacheControl(c *gin.Context) {
+	if h.site.CacheMaxAge > 0 {
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(h.site.CacheMaxAge.Seconds())))
+	} else {
+		c.Header("Cache-Control", "no-cache")
+	}
+}
+
+// render: Content-Type once yazilir, gin'in XML render'i mevcut Content-Type'i degistirmez.
+func (h *Handler) render(c *gin.Context, contentType string, v any) {
+	c.Header("Content-Type", contentType)
+	c.XML(http.StatusOK, document{v: v})
+}
+
+func (h *Handler) title(category *Category) string {
+	if category == nil {
+		return h.site.Title
+	}
+	return h.site.Title + " - " + category.Name
+}
+
+func (h *Handler) articleURL(slug string) string {
+	return h.site.URL + strings.ReplaceAll(h.site.ArticlePath, "{slug}", slug)
+}
+
+func (h *Handler) categoryURL(slug string) string {
+	return h.site.URL + strings.ReplaceAll(h.site.CategoryPath, "{slug}", slug)
+}
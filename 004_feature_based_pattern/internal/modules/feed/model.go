@@ -0,0 +1,134 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Entry: feed'deki bir makale (yayinlanmis, aktif ve silinmemis).
+type Entry struct {
+	ID          int64
+	Title       string
+	Slug        string
+	Summary     *string // short_description
+	Content     string  // description (sanitize edilmis HTML)
+	Author      *string // yazarin adi, user_id yoksa veya kullanici silinmisse nil
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+	Categories  []Category
+}
+
+// Category: feed ve sitemap icin aktif bir kategori.
+type Category struct {
+	ID        int64
+	Name      string
+	Slug      string
+	UpdatedAt time.Time
+}
+
+// Page: sitemap'teki bir makale.
+type Page struct {
+	Slug      string
+	UpdatedAt time.Time
+}
+
+// RSS 2.0 (https://www.rssboard.org/rss-specification). content:encoded makalenin tamamini, description ozeti tasir.
+type rss struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Self          atomLink  `xml:"atom:link"` // feed'in kendi adresi (rel="self")
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Description string   `xml:"description,omitempty"`
+	Content     string   `xml:"content:encoded"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// Atom 1.0 (RFC 4287).
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	ID       string      `xml:"id"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Author   atomPerson  `xml:"author"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomPerson    `xml:"author"`
+	Summary    *atomText      `xml:"summary"`
+	Content    atomText       `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+// Sitemap 0.9 (https://www.sitemaps.org/protocol.html).
+type urlSet struct {
+	XMLName xml.Name     `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod"`
+}
+
+// document: XML bildirimini (<?xml ...?>) ekleyerek v'yi yazar; gin'in XML render'i bildirim eklemez.
+type document struct {
+	v any
+}
+
+func (d document) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	if err := e.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)}); err != nil {
+		return err
+	}
+	return e.Encode(d.v)
+}
@@ -0,0 +1,194 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+// Repository: feed ve sitemap icin salt okunur sorgular. Sadece yayinlanmis (status = published), aktif ve silinmemis
+// makaleler ile aktif kategoriler kullanilir.
+type Repository interface {
+	// Entries: en son yayinlanandan baslayarak en fazla limit makale; categoryID > 0 ise sadece o kategoridekiler.
+	Entries(ctx context.Context, categoryID int64, limit int) ([]Entry, error)
+	// Category: slug'a gore aktif kategori, yoksa database.ErrNotFound.
+	Category(ctx context.Context, slug string) (*Category, error)
+	Categories(ctx context.Context) ([]Category, error)
+	// Pages: sitemap icin makaleler, en son guncellenenden baslayarak en fazla limit adet.
+	Pages(ctx context.Context, limit int) ([]Page, error)
+	// LastModified: articles, categories ve yayindaki makalelerin yazarlarindaki en son updated_at/deleted_at. Yayindan
+	// kaldirma, silme ve kategori degisiklikleri de updated_at'i degistirdigi icin feed'ler icin guvenli bir Last-Modified degeridir.
+	LastModified(ctx context.Context) (time.Time, error)
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const published = "a.status = 'published' AND a.is_active AND a.deleted_at IS NULL"
+
+func (r *sqlRepository) Entries(ctx context.Context, categoryID int64, limit int) ([]Entry, error) {
+	q := `SELECT a.id, a.title, a.slug, a.short_description, a.description, u.name, a.published_at, a.updated_at
+		FROM articles a LEFT JOIN users u ON u.id = a.user_id AND u.deleted_at IS NULL
+		WHERE ` + published
+	args := []any{}
+	if categoryID > 0 {
+		q += " AND EXISTS (SELECT 1 FROM article_categories ac WHERE ac.article_id = a.id AND ac.category_id = ?)"
+		args = append(args, categoryID)
+	}
+	q += " ORDER BY a.published_at DESC, a.id DESC LIMIT ?"
+
+	rows, err := r.db.QueryContext(ctx, q, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		var (
+			e           Entry
+			summary     sql.NullString
+			author      sql.NullString
+			publishedAt sql.NullTime
+		)
+		if err := rows.Scan(&e.ID, &e.Title, &e.Slug, &summary, &e.Content, &author, &publishedAt, &e.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if summary.Valid {
+			e.Summary = &summary.String
+		}
+		if author.Valid {
+			e.Author = &author.String
+		}
+		// published durumundaki makalelerde published_at her zaman doludur (bkz. article.newTransition)
+		e.PublishedAt = e.UpdatedAt
+		if publishedAt.Valid {
+			e.PublishedAt = publishedAt.Time
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, r.loadCategories(ctx, entries)
+}
+
+// loadCategories: makalelerin aktif kategorilerini tek sorguda doldurur.
+func (r *sqlRepository) loadCategories(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*Entry, len(entries))
+	args := make([]any, len(entries))
+	for i := range entries {
+		byID[entries[i].ID] = &entries[i]
+		args[i] = entries[i].ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT ac.article_id, c.id, c.name, c.slug, c.updated_at
+		FROM article_categories ac JOIN categories c ON c.id = ac.category_id
+		WHERE c.is_active AND ac.article_id IN (`+database.Placeholders(len(args))+`) ORDER BY c.name`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			articleID int64
+			c         Category
+		)
+		if err := rows.Scan(&articleID, &c.ID, &c.Name, &c.Slug, &c.UpdatedAt); err != nil {
+			return err
+		}
+		if e := byID[articleID]; e != nil {
+			e.Categories = append(e.Categories, c)
+		}
+	}
+	return rows.Err()
+}
+
+func (r *sqlRepository) Category(ctx context.Context, slug string) (*Category, error) {
+	var c Category
+	err := r.db.QueryRowContext(ctx, "SELECT id, name, slug, updated_at FROM categories WHERE slug = ? AND is_active", slug).
+		Scan(&c.ID, &c.Name, &c.Slug, &c.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *sqlRepository) Categories(ctx context.Context) ([]Category, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, slug, updated_at FROM categories WHERE is_active ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []Category
+	for rows.Next() {
+		var c Category
+		if err := rows.Scan(&c.ID, &c.Name, &c.Slug, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		categories = append(categories, c)
+	}
+	return categories, rows.Err()
+}
+
+func (r *sqlRepository) Pages(ctx context.Context, limit int) ([]Page, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT a.slug, a.updated_at FROM articles a WHERE "+published+
+		" ORDER BY a.updated_at DESC, a.id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var pages []Page
+	for rows.Next() {
+		var p Page
+		if err := rows.Scan(&p.Slug, &p.UpdatedAt); err != nil {
+			return nil, err
+		}
+		pages = append(pages, p)
+	}
+	return pages, rows.Err()
+}
+
+// LastModified: MAX() yerine ORDER BY ... LIMIT 1 kullanilir; sqlite'ta aggregate sonuclarin tipi olmadigi icin
+// MAX(updated_at) time.Time'a taranamaz. Yazar adi entry'lerde gorundugu icin yayindaki makalelerin yazarlarinin
+// updated_at ve deleted_at degerleri de hesaba katilir (silinen yazarin adi feed'den kalkar).
+func (r *sqlRepository) LastModified(ctx context.Context) (time.Time, error) {
+	const authors = "FROM users u WHERE EXISTS (SELECT 1 FROM articles a WHERE a.user_id = u.id AND " + published + ")"
+
+	var last time.Time
+	for _, q := range []string{
+		"SELECT updated_at FROM articles ORDER BY updated_at DESC LIMIT 1",
+		"SELECT updated_at FROM categories ORDER BY updated_at DESC LIMIT 1",
+		"SELECT u.updated_at " + authors + " ORDER BY u.updated_at DESC LIMIT 1",
+		"SELECT u.deleted_at " + authors + " AND u.deleted_at IS NOT NULL ORDER BY u.deleted_at DESC LIMIT 1",
+	} {
+		var t time.Time
+		err := r.db.QueryRowContext(ctx, q).Scan(&t)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, err
+		}
+		if t.After(last) {
+			last = t
+		}
+	}
+	return last, nil
+}
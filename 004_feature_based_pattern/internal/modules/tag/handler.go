@@ -0,0 +1,57 @@
+package tag
+
+import (
+	"cmp"
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/slug"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler: tag endpoint'leri. Etiketteki makaleler icin bkz. article.Handler.ArticlesByTagHandler.
+type Handler struct {
+	repo Repository
+}
+
+func NewHandler(repo Repository) *Handler {
+	return &Handler{repo: repo}
+}
+
+// PopularTagsHandler: GET /tags/popular?limit=
+func (h *Handler) PopularTagsHandler(c *gin.Context) {
+	var req PopularTagsRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+
+	tags, err := h.repo.Popular(c.Request.Context(), cmp.Or(req.Limit, 20))
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", tags)
+}
+
+// AutocompleteTagsHandler: GET /tags/autocomplete?q=&limit=, q'dan slug uretilemiyorsa (orn: "!!") bos liste doner.
+func (h *Handler) AutocompleteTagsHandler(c *gin.Context) {
+	var req AutocompleteTagsRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+
+	prefix := slug.Make(req.Q)
+	if prefix == "" {
+		api.SendSuccess(c, http.StatusOK, "request.ok", []Tag{})
+		return
+	}
+
+	tags, err := h.repo.Autocomplete(c.Request.Context(), prefix, cmp.Or(req.Limit, 10))
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", tags)
+}
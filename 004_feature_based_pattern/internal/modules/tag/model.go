@@ -0,0 +1,12 @@
+package tag
+
+import "time"
+
+// Tag: tags tablosundaki bir kayit. Etiketler makale yazilirken olusturulur (bkz. article.normalizeTags).
+type Tag struct {
+	ID           int64     `json:"id" xml:"id" yaml:"id"`
+	Name         string    `json:"name" xml:"name" yaml:"name"`
+	Slug         string    `json:"slug" xml:"slug" yaml:"slug"`
+	ArticleCount int       `json:"article_count" xml:"article_count" yaml:"article_count"` // silinmemis makale sayisi
+	CreatedAt    time.Time `json:"created_at" xml:"created_at" yaml:"created_at"`
+}
@@ -0,0 +1,59 @@
+package tag
+
+import (
+	"context"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+// Repository: tag modulunun salt okunur sorgulari. Etiketler article modulu tarafindan yazilir.
+// ArticleCount sadece silinmemis makaleleri sayar.
+type Repository interface {
+	// Popular: en az bir makalede kullanilan etiketler, makale sayisina (esitse isme) gore azalan sirada.
+	Popular(ctx context.Context, limit int) ([]Tag, error)
+	// Autocomplete: slug'i prefix ile baslayan etiketler, en cok kullanilandan baslayarak.
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]Tag, error)
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+// tagsWithCount: etiketler ve silinmemis makale sayilari, WHERE / GROUP BY / ORDER BY sorgu metodlarinda eklenir.
+const tagsWithCount = `SELECT t.id, t.name, t.slug, t.created_at, COUNT(a.id) AS article_count
+	FROM tags t
+	LEFT JOIN article_tags ta ON ta.tag_id = t.id
+	LEFT JOIN articles a ON a.id = ta.article_id AND a.deleted_at IS NULL`
+
+const groupByTag = " GROUP BY t.id, t.name, t.slug, t.created_at"
+
+func (r *sqlRepository) Popular(ctx context.Context, limit int) ([]Tag, error) {
+	return r.query(ctx, tagsWithCount+groupByTag+" HAVING COUNT(a.id) > 0 ORDER BY article_count DESC, t.name LIMIT ?", limit)
+}
+
+// Autocomplete: prefix pkg/slug ciktisi oldugu icin (a-z, 0-9, -) LIKE ozel karakterlerini icermez.
+func (r *sqlRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]Tag, error) {
+	return r.query(ctx, tagsWithCount+" WHERE t.slug LIKE ?"+groupByTag+" ORDER BY article_count DESC, t.name LIMIT ?", prefix+"%", limit)
+}
+
+func (r *sqlRepository) query(ctx context.Context, q string, args ...any) ([]Tag, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []Tag{}
+	for rows.Next() {
+		var t Tag
+		if err := rows.Scan(&t.ID, &t.Name, &t.Slug, &t.CreatedAt, &t.ArticleCount); err != nil {
+			return nil, err
+		}
+		tags = append(tags, t)
+	}
+	return tags, rows.Err()
+}
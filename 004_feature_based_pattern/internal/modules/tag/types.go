@@ -0,0 +1,13 @@
+package tag
+
+// PopularTagsRequest: GET /tags/popular query parametreleri.
+type PopularTagsRequest struct {
+	Limit int `form:"limit" json:"limit" binding:"omitempty,min=1,max=100"` // verilmezse 20
+}
+
+// AutocompleteTagsRequest: GET /tags/autocomplete query parametreleri. q slug kurallariyla normalize edilip
+// etiket slug'larinin basiyla karsilastirilir ("go l" -> "go-l%").
+type AutocompleteTagsRequest struct {
+	Q     string `form:"q" json:"q" binding:"required,max=50"`
+	Limit int    `form:"limit" json:"limit" binding:"omitempty,min=1,max=50"` // verilmezse 10
+}
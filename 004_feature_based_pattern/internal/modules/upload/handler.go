@@ -0,0 +1,307 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/storage"
+	"feature-base-starter-kit/pkg/validation"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead: MaxSize'a ek olarak multipart sinirlari ve basliklari icin izin verilen byte.
+const multipartOverhead = 64 << 10
+
+// Options: yukleme kurallari (bkz. config.UploadConfig).
+type Options struct {
+	MaxSize        int64 // byte
+	MinDimension   int   // gorselin kisa kenari, px
+	MaxDimension   int   // gorselin uzun kenari, px
+	ThumbnailSizes []int
+}
+
+// Handler: avatar ve kapak gorseli endpoint'leri ile /files altindaki imzali dosya indirme endpoint'i.
+type Handler struct {
+	repo   Repository
+	tx     *database.TxManager
+	store  storage.Storage
+	signer *storage.Signer
+	opts   Options
+}
+
+func NewHandler(repo Repository, tx *database.TxManager, store storage.Storage, signer *storage.Signer, opts Options) *Handler {
+	return &Handler{repo: repo, tx: tx, store: store, signer: signer, opts: opts}
+}
+
+// ownerNotFound: turun sahibi icin 404 mesaji.
+var ownerNotFound = map[Kind]string{
+	KindAvatar: "user.not_found",
+	KindCover:  "article.not_found",
+}
+
+// PutHandler: PUT /users/:id/avatar, /articles/:id/cover (multipart, "file" alani). Mevcut gorselin yerine gecer.
+// Boyut siniri asilirsa 413, icerik desteklenen bir gorsel degilse 415, boyutlari sinir disindaysa 422 doner.
+func (h *Handler) PutHandler(kind Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ownerID, ok := api.ParamID(c, "id")
+		if !ok {
+			api.SendError(c, http.StatusNotFound, ownerNotFound[kind], nil, c.Param("id"))
+			return
+		}
+
+		data, ok := h.readFile(c)
+		if !ok {
+			return
+		}
+
+		ctx := c.Request.Context()
+		if err := h.repo.CheckOwner(ctx, kind, ownerID); err != nil {
+			sendRepositoryError(c, err, kind, ownerID)
+			return
+		}
+
+		original, thumbs, err := process(data, h.opts)
+		if err != nil {
+			sendProcessError(c, err)
+			return
+		}
+
+		u, err := h.save(ctx, kind, ownerID, original, thumbs)
+		if err != nil {
+			sendRepositoryError(c, err, kind, ownerID)
+			return
+		}
+
+		api.SendSuccess(c, http.StatusCreated, "upload.saved", h.view(u))
+	}
+}
+
+// GetHandler: GET /users/:id/avatar, /articles/:id/cover; gorselin bilgileri ve imzali URL'leri.
+func (h *Handler) GetHandler(kind Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ownerID, ok := api.ParamID(c, "id")
+		if !ok {
+			api.SendError(c, http.StatusNotFound, ownerNotFound[kind], nil, c.Param("id"))
+			return
+		}
+
+		u, err := h.repo.Get(c.Request.Context(), kind, ownerID)
+		if err != nil {
+			sendRepositoryError(c, err, kind, ownerID)
+			return
+		}
+
+		api.SendSuccess(c, http.StatusOK, "request.ok", h.view(u))
+	}
+}
+
+// DeleteHandler: DELETE /users/:id/avatar, /articles/:id/cover; kayit ve dosyalar kalici olarak silinir.
+func (h *Handler) DeleteHandler(kind Kind) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ownerID, ok := api.ParamID(c, "id")
+		if !ok {
+			api.SendError(c, http.StatusNotFound, ownerNotFound[kind], nil, c.Param("id"))
+			return
+		}
+
+		u, err := h.repo.Delete(c.Request.Context(), kind, ownerID)
+		if err != nil {
+			sendRepositoryError(c, err, kind, ownerID)
+			return
+		}
+		h.deleteFiles(c.Request.Context(), u.keys())
+
+		api.SendSuccess(c, http.StatusOK, "upload.deleted", nil)
+	}
+}
+
+// ServeFileHandler: GET/HEAD /files/*key?expires=&signature= (auth gerektirmez, bkz. storage.Signer).
+// Imza gecersiz veya suresi dolmussa 403 doner. Range ve If-Modified-Since istekleri desteklenir.
+func (h *Handler) ServeFileHandler(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	if !storage.ValidKey(key) || !h.signer.Verify(key, c.Query("expires"), c.Query("signature")) {
+		api.SendError(c, http.StatusForbidden, "upload.link_expired", nil)
+		return
+	}
+
+	f, obj, err := h.store.Open(c.Request.Context(), key)
+	if errors.Is(err, storage.ErrNotFound) {
+		api.SendError(c, http.StatusNotFound, "upload.not_found", nil)
+		return
+	}
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+	defer f.Close()
+
+	// URL'in suresi dolana kadar onbellekte tutulabilir; key'ler her yuklemede degistigi icin icerik degismez
+	expires, _ := strconv.ParseInt(c.Query("expires"), 10, 64)
+	maxAge := max(0, expires-time.Now().Unix())
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", maxAge))
+	c.Header("Content-Type", obj.ContentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+	http.ServeContent(c.Writer, c.Request, "", obj.ModTime, f)
+}
+
+// readFile: istekteki "file" alanini MaxSize'a kadar okur; hata varsa cevabi gonderir ve false doner.
+func (h *Handler) readFile(c *gin.Context) ([]byte, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.opts.MaxSize+multipartOverhead)
+
+	fh, err := c.FormFile("file")
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge) || err == nil && fh.Size > h.opts.MaxSize:
+		api.SendError(c, http.StatusRequestEntityTooLarge, "upload.too_large", nil, h.opts.MaxSize)
+		return nil, false
+	case errors.Is(err, http.ErrMissingFile):
+		loc := i18n.FromContext(c)
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("file"): {loc.T("upload.file_required")},
+		})
+		return nil, false
+	case err != nil:
+		api.SendError(c, http.StatusBadRequest, "request.invalid_payload", nil)
+		return nil, false
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return nil, false
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+		return nil, false
+	}
+	return data, true
+}
+
+// save: dosyalari yeni key'lerle Storage'a yazar, kaydi degistirir ve eski dosyalari siler. Veritabani islemi
+// basarisiz olursa yeni dosyalar silinir; eski dosyalar sadece yeni kayit commit edildikten sonra silinir.
+func (h *Handler) save(ctx context.Context, kind Kind, ownerID int64, original rendition, thumbs []rendition) (*Upload, error) {
+	prefix, err := keyPrefix(kind, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &Upload{
+		Kind:        kind,
+		OwnerID:     ownerID,
+		Key:         prefix + "original." + original.Ext,
+		ContentType: original.ContentType,
+		Size:        int64(len(original.Data)),
+		Width:       original.Width,
+		Height:      original.Height,
+	}
+	for _, t := range thumbs {
+		u.Thumbnails = append(u.Thumbnails, Thumbnail{
+			Size:   t.Size,
+			Key:    fmt.Sprintf("%s%d.%s", prefix, t.Size, t.Ext),
+			Width:  t.Width,
+			Height: t.Height,
+		})
+	}
+
+	files := append([]rendition{original}, thumbs...)
+	keys := u.keys()
+	for i, f := range files {
+		if err := h.store.Put(ctx, keys[i], bytes.NewReader(f.Data), int64(len(f.Data)), f.ContentType); err != nil {
+			h.deleteFiles(ctx, keys[:i])
+			return nil, err
+		}
+	}
+
+	var old *Upload
+	err = h.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		old, err = h.repo.Delete(ctx, kind, ownerID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		return h.repo.Create(ctx, u)
+	})
+	if err != nil {
+		h.deleteFiles(ctx, keys)
+		return nil, err
+	}
+
+	if old != nil {
+		h.deleteFiles(ctx, old.keys())
+	}
+	return u, nil
+}
+
+// deleteFiles: dosyalari siler. Hatalar sadece loglanir; kalan dosyalara imzali URL uretilmedigi icin erisilemez.
+func (h *Handler) deleteFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := h.store.Delete(ctx, key); err != nil {
+			log.Printf("Upload: delete %s: %v", key, err)
+		}
+	}
+}
+
+// view: kaydi istemciye gonderilecek hale getirir, orijinal ve thumbnail'ler icin imzali URL'ler uretir.
+func (h *Handler) view(u *Upload) *Upload {
+	v := *u
+	v.URL, v.ExpiresAt = h.signer.URL(u.Key)
+	v.Thumbnails = make([]Thumbnail, len(u.Thumbnails))
+	for i, t := range u.Thumbnails {
+		t.URL, _ = h.signer.URL(t.Key)
+		v.Thumbnails[i] = t
+	}
+	return &v
+}
+
+// keyPrefix: her yukleme icin yeni bir dizin, orn: "avatars/42/9f86d081884c7d65/". Key'ler tahmin edilemez ve
+// yeni yukleme farkli URL uretir (onbelleklerde eski gorsel kalmaz).
+func keyPrefix(kind Kind, ownerID int64) (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%ss/%d/%s/", kind, ownerID, hex.EncodeToString(b)), nil
+}
+
+func sendProcessError(c *gin.Context, err error) {
+	var dim *DimensionError
+	switch {
+	case errors.As(err, &dim):
+		loc := i18n.FromContext(c)
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("file"): {loc.T("upload.invalid_dimensions", dim.Min, dim.Max, dim.Width, dim.Height)},
+		})
+	case errors.Is(err, ErrUnsupportedType):
+		api.SendError(c, http.StatusUnsupportedMediaType, "upload.unsupported_type", nil)
+	default:
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
+}
+
+func sendRepositoryError(c *gin.Context, err error, kind Kind, ownerID int64) {
+	switch {
+	case errors.Is(err, ErrOwnerNotFound):
+		api.SendError(c, http.StatusNotFound, ownerNotFound[kind], nil, ownerID)
+	case errors.Is(err, database.ErrNotFound):
+		api.SendError(c, http.StatusNotFound, "upload.not_found", nil)
+	default:
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
+}
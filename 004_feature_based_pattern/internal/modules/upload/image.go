@@ -0,0 +1,123 @@
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // image.Decode icin decoder kaydi
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	// ErrUnsupportedType: icerik (uzanti veya istemcinin Content-Type'i degil) desteklenen bir gorsel degil.
+	ErrUnsupportedType = errors.New("upload: unsupported image type")
+	// ErrInvalidDimensions: gorselin boyutlari Options.MinDimension / MaxDimension disinda.
+	ErrInvalidDimensions = errors.New("upload: image dimensions out of range")
+)
+
+// extensions: desteklenen icerik turleri ve Storage key'lerinde kullanilan uzantilari (bkz. storage.Local).
+var extensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// rendition: Storage'a yazilacak bir dosya (orijinal veya thumbnail).
+type rendition struct {
+	Size        int // thumbnail kutusu, orijinal icin 0
+	Width       int
+	Height      int
+	ContentType string
+	Ext         string
+	Data        []byte
+}
+
+// process: icerik turunu ilk byte'lardan (http.DetectContentType) bulur, boyutlari tum gorseli decode etmeden
+// kontrol eder ve her thumbnail boyutu icin kucuk gorsel uretir. Orijinal degistirilmeden saklanir.
+// Thumbnail'ler JPEG kaynaklar icin JPEG, digerleri icin (seffaflik kaybolmasin diye) PNG olarak kodlanir.
+func process(data []byte, opts Options) (rendition, []rendition, error) {
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		return rendition{}, nil, fmt.Errorf("%w: %s", ErrUnsupportedType, contentType)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return rendition{}, nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+	if min(cfg.Width, cfg.Height) < opts.MinDimension || max(cfg.Width, cfg.Height) > opts.MaxDimension {
+		return rendition{}, nil, &DimensionError{Width: cfg.Width, Height: cfg.Height, Min: opts.MinDimension, Max: opts.MaxDimension}
+	}
+
+	original := rendition{Width: cfg.Width, Height: cfg.Height, ContentType: contentType, Ext: ext, Data: data}
+	if len(opts.ThumbnailSizes) == 0 {
+		return original, nil, nil
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data)) // gif icin ilk kare
+	if err != nil {
+		return rendition{}, nil, fmt.Errorf("%w: %v", ErrUnsupportedType, err)
+	}
+
+	thumbs := make([]rendition, 0, len(opts.ThumbnailSizes))
+	for _, size := range opts.ThumbnailSizes {
+		t, err := thumbnail(src, size, contentType == "image/jpeg")
+		if err != nil {
+			return rendition{}, nil, err
+		}
+		thumbs = append(thumbs, t)
+	}
+	return original, thumbs, nil
+}
+
+// thumbnail: src'yi oranini koruyarak size x size kutusuna sigdirir; kutudan kucuk gorseller buyutulmez.
+func thumbnail(src image.Image, size int, asJPEG bool) (rendition, error) {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > size || h > size {
+		if w >= h {
+			w, h = size, max(1, h*size/w)
+		} else {
+			w, h = max(1, w*size/h), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Src, nil)
+
+	var buf bytes.Buffer
+	t := rendition{Size: size, Width: w, Height: h}
+	if asJPEG {
+		t.ContentType, t.Ext = "image/jpeg", "jpg"
+		if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85}); err != nil {
+			return rendition{}, err
+		}
+	} else {
+		t.ContentType, t.Ext = "image/png", "png"
+		if err := png.Encode(&buf, dst); err != nil {
+			return rendition{}, err
+		}
+	}
+	t.Data = buf.Bytes()
+	return t, nil
+}
+
+// DimensionError: ErrInvalidDimensions'in detaylari, istemciye gonderilen mesajda kullanilir.
+type DimensionError struct {
+	Width, Height int
+	Min, Max      int
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("%v: %dx%d (min %d, max %d)", ErrInvalidDimensions, e.Width, e.Height, e.Min, e.Max)
+}
+
+func (e *DimensionError) Unwrap() error { return ErrInvalidDimensions }
@@ -0,0 +1,45 @@
+package upload
+
+import "time"
+
+// Kind: gorselin turu, sahibinin tablosunu belirler (bkz. owners).
+type Kind string
+
+const (
+	KindAvatar Kind = "avatar" // owner_id = users.id
+	KindCover  Kind = "cover"  // owner_id = articles.id
+)
+
+// Upload: uploads tablosundaki bir kayit. Key'ler disari verilmez; dosyalar imzali URL'lerle sunulur (bkz. Handler.view).
+type Upload struct {
+	ID          int64       `json:"id" xml:"id" yaml:"id"`
+	Kind        Kind        `json:"kind" xml:"kind" yaml:"kind"`
+	OwnerID     int64       `json:"owner_id" xml:"owner_id" yaml:"owner_id"`
+	Key         string      `json:"-" xml:"-" yaml:"-"`
+	ContentType string      `json:"content_type" xml:"content_type" yaml:"content_type"`
+	Size        int64       `json:"size" xml:"size" yaml:"size"` // byte
+	Width       int         `json:"width" xml:"width" yaml:"width"`
+	Height      int         `json:"height" xml:"height" yaml:"height"`
+	URL         string      `json:"url" xml:"url" yaml:"url"`
+	ExpiresAt   time.Time   `json:"expires_at" xml:"expires_at" yaml:"expires_at"` // URL'lerin gecerlilik suresi
+	Thumbnails  []Thumbnail `json:"thumbnails" xml:"thumbnails>thumbnail" yaml:"thumbnails"`
+	CreatedAt   time.Time   `json:"created_at" xml:"created_at" yaml:"created_at"`
+}
+
+// Thumbnail: gorselin Size x Size kutusuna sigdirilmis kucuk hali, kucuk gorseller buyutulmez.
+type Thumbnail struct {
+	Size   int    `json:"size" xml:"size" yaml:"size"`
+	Key    string `json:"-" xml:"-" yaml:"-"`
+	Width  int    `json:"width" xml:"width" yaml:"width"`
+	Height int    `json:"height" xml:"height" yaml:"height"`
+	URL    string `json:"url" xml:"url" yaml:"url"`
+}
+
+// keys: kayda ait Storage'daki tum dosyalar.
+func (u *Upload) keys() []string {
+	keys := []string{u.Key}
+	for _, t := range u.Thumbnails {
+		keys = append(keys, t.Key)
+	}
+	return keys
+}
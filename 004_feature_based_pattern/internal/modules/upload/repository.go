@@ -0,0 +1,118 @@
+package upload
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+// ErrOwnerNotFound: gorselin sahibi (kullanici veya makale) yok ya da silinmis.
+var ErrOwnerNotFound = errors.New("upload: owner not found")
+
+// owners: her turun sahibini kontrol eden sorgu. Soft delete edilmis kayitlara gorsel yuklenemez.
+var owners = map[Kind]string{
+	KindAvatar: "SELECT 1 FROM users WHERE id = ? AND deleted_at IS NULL",
+	KindCover:  "SELECT 1 FROM articles WHERE id = ? AND deleted_at IS NULL",
+}
+
+// Repository: upload modulunun veritabani islemleri. Dosyalarin kendisi Storage'dadir, burada sadece key'ler tutulur.
+// Kaydi olmayan gorseller icin database.ErrNotFound doner.
+type Repository interface {
+	// CheckOwner: sahibi yoksa ErrOwnerNotFound.
+	CheckOwner(ctx context.Context, kind Kind, ownerID int64) error
+	Get(ctx context.Context, kind Kind, ownerID int64) (*Upload, error)
+	// Create: (kind, owner_id) benzersizdir, mevcut kayit once Delete ile silinmelidir (bkz. Handler.put).
+	Create(ctx context.Context, u *Upload) error
+	// Delete: kaydi siler ve silinen kaydi dondurur (dosyalari Storage'dan silmek icin).
+	Delete(ctx context.Context, kind Kind, ownerID int64) (*Upload, error)
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const uploadColumns = "id, kind, owner_id, storage_key, content_type, size, width, height, thumbnails, created_at"
+
+// storedThumbnail: thumbnails kolonundaki JSON (Thumbnail'in Key alani disari verilmedigi icin ayri tip).
+type storedThumbnail struct {
+	Size   int    `json:"size"`
+	Key    string `json:"key"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+func (r *sqlRepository) CheckOwner(ctx context.Context, kind Kind, ownerID int64) error {
+	var one int
+	err := r.db.QueryRowContext(ctx, owners[kind], ownerID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrOwnerNotFound
+	}
+	return err
+}
+
+func (r *sqlRepository) Get(ctx context.Context, kind Kind, ownerID int64) (*Upload, error) {
+	var (
+		u      Upload
+		thumbs string
+	)
+	err := r.db.QueryRowContext(ctx, "SELECT "+uploadColumns+" FROM uploads WHERE kind = ? AND owner_id = ?", kind, ownerID).
+		Scan(&u.ID, &u.Kind, &u.OwnerID, &u.Key, &u.ContentType, &u.Size, &u.Width, &u.Height, &thumbs, &u.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []storedThumbnail
+	if err := json.Unmarshal([]byte(thumbs), &stored); err != nil {
+		return nil, err
+	}
+	u.Thumbnails = make([]Thumbnail, len(stored))
+	for i, t := range stored {
+		u.Thumbnails[i] = Thumbnail{Size: t.Size, Key: t.Key, Width: t.Width, Height: t.Height}
+	}
+	return &u, nil
+}
+
+func (r *sqlRepository) Create(ctx context.Context, u *Upload) error {
+	stored := make([]storedThumbnail, len(u.Thumbnails))
+	for i, t := range u.Thumbnails {
+		stored[i] = storedThumbnail{Size: t.Size, Key: t.Key, Width: t.Width, Height: t.Height}
+	}
+	thumbs, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.db.Insert(ctx, `INSERT INTO uploads (kind, owner_id, storage_key, content_type, size, width, height, thumbnails)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		u.Kind, u.OwnerID, u.Key, u.ContentType, u.Size, u.Width, u.Height, string(thumbs)); err != nil {
+		return err
+	}
+
+	fresh, err := r.Get(ctx, u.Kind, u.OwnerID)
+	if err != nil {
+		return err
+	}
+	*u = *fresh
+	return nil
+}
+
+func (r *sqlRepository) Delete(ctx context.Context, kind Kind, ownerID int64) (*Upload, error) {
+	u, err := r.Get(ctx, kind, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM uploads WHERE id = ?", u.ID); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"feature-base-starter-kit/pkg/api"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportColumns: disa aktarilan kolonlar, sirasi exportRow ile ayni olmalidir.
+var exportColumns = []string{"id", "name", "email", "age", "is_active", "version", "created_at", "updated_at", "deleted_at"}
+
+// ExportUsersHandler: GET /users/export?with_deleted=true. Format Accept header'iyla secilir: text/csv (varsayilan),
+// application/x-ndjson veya XLSX. Kayitlar bellege alinmadan veritabani cursor'undan yazilir.
+func (h *Handler) ExportUsersHandler(c *gin.Context) {
+	opts := ListOptions{WithDeleted: api.QueryBool(c, "with_deleted")}
+
+	api.Export(c, "users", exportColumns, func(write api.RowFunc) error {
+		return h.repo.Export(c.Request.Context(), opts, func(u *User) error {
+			return write(exportRow(u))
+		})
+	})
+}
+
+func exportRow(u *User) []any {
+	var deletedAt any
+	if u.DeletedAt != nil {
+		deletedAt = *u.DeletedAt
+	}
+	return []any{u.ID, u.Name, u.Email, u.Age, u.IsActive, u.Version, u.CreatedAt, u.UpdatedAt, deletedAt}
+}
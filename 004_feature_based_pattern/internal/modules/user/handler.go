@@ -3,14 +3,25 @@ package user
 import (
 	"errors"
 	"feature-base-starter-kit/pkg/api"
-	"feature-base-starter-kit/pkg/validation"
+	"feature-base-starter-kit/pkg/database"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 )
 
-func CreateUserHandler(c *gin.Context) {
+// Handler: user endpoint'leri. Veritabani islemleri Repository uzerinden yapilir.
+type Handler struct {
+	repo    Repository
+	tx      *database.TxManager // toplu yukleme icin, bkz. ImportUsersHandler
+	imports ImportOptions
+}
+
+func NewHandler(repo Repository, tx *database.TxManager, imports ImportOptions) *Handler {
+	return &Handler{repo: repo, tx: tx, imports: imports}
+}
+
+func (h *Handler) CreateUserHandler(c *gin.Context) {
 	var req CreateUserRequest // Request DTO instance
 
 	// ShouldBindJSON: gelen JSON verisini req struct'ina bind eder
@@ -22,57 +33,191 @@ func CreateUserHandler(c *gin.Context) {
 			// ve : validation hatalari icerisinde olur
 			// ok : dogrulama basarili mi
 
-			c.JSON(http.StatusUnprocessableEntity, api.APIErrorResponse{
-				Message: "Validation Failed",
-				Errors:  validation.MapValidationErrors(ve),
-			})
+			api.SendValidationError(c, ve)
 			return
 		}
 
-		c.JSON(http.StatusBadRequest, api.APIErrorResponse{
-			Message: "Invalid Request Payload",
-		})
+		api.SendError(c, http.StatusBadRequest, "request.invalid_payload", nil)
+		return
+	}
+
+	// DB'ye sorgu atmasi gereken kurallar (unique, exists) request context'i ile ayrica calistirilir.
+	if !api.ValidateAsync(c, &req) {
 		return
 	}
 
 	// Kod buraya kadar gelirse, validation basarili demektir. Fakat business kurallari kontrol edilmemistir. Ornegin: DB'ye kayit eklenirken hata olusabilir.
-	// Business hata varsayayimi:
-	if err := pretendDBInsert(req); err != nil {
-		// db insert hatasi, duplicate email hatasi, timeout hatasi vs gibi.
-		c.JSON(http.StatusInternalServerError, api.APIErrorResponse{
-			Message: "Internal Server Error",
-			//Message: err.Error(),
-		})
-		return
-	}
-
-	format := c.Query("format")
-	response := api.APISuccessResponse{
-		Message: "User Created Successfully",
-		Data: gin.H{
-			"name":  req.Name,
-			"email": req.Email,
-			"age":   req.Age,
-		},
-	}
-
-	// Basarili response
-	switch format {
-	case "xml":
-		c.XML(http.StatusCreated, response)
-	case "yaml", "yml":
-		c.YAML(http.StatusCreated, response)
-	default:
-		c.JSON(http.StatusCreated, response)
+	u := &User{Name: req.Name, Email: req.Email, Age: req.Age, IsActive: true}
+	if err := h.repo.Create(c.Request.Context(), u); err != nil {
+		// async kontrol ile insert arasinda ayni email baska bir istekle eklenmis olabilir
+		sendRepositoryError(c, err, 0)
+		return
 	}
 
+	// Basarili response (?format=xml|yaml desteklenir)
+	api.SendSuccess(c, http.StatusCreated, "user.created", u)
 }
 
-func pretendDBInsert(req CreateUserRequest) error {
-	// Simule edilen DB insert islemi
-	if len(req.Email) >= 5 && req.Email[:5] == "fail@" {
-		return errors.New("simulated database error")
+func (h *Handler) GetUserHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, c.Param("id"))
+		return
+	}
+
+	// ?with_deleted=true: silinmis kayitlar da gosterilir
+	u, err := h.repo.GetByID(c.Request.Context(), id, api.QueryBool(c, "with_deleted"))
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", u)
+}
+
+func (h *Handler) ListUsersHandler(c *gin.Context) {
+	p := api.PaginationFrom(c)
+
+	users, total, err := h.repo.List(c.Request.Context(), ListOptions{
+		Limit:       p.Limit(),
+		Offset:      p.Offset(),
+		WithDeleted: api.QueryBool(c, "with_deleted"),
+	})
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "request.ok", api.NewPage(users, p, total))
+}
+
+func (h *Handler) UpdateUserHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
+	}
+
+	var req UpdateUserRequest
+	if !api.BindJSON(c, &req) {
+		return
+	}
+
+	u := &User{ID: id, Name: req.Name, Email: req.Email, Age: req.Age, IsActive: *req.IsActive, Version: current.Version}
+	if err := h.repo.Update(c.Request.Context(), u); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "user.updated", u)
+}
+
+// PatchUserHandler: kaydi application/merge-patch+json veya application/json-patch+json govdesiyle kismen gunceller.
+// Patch kaydin guncel haline uygulanir, sonuc UpdateUserRequest kurallariyla dogrulanir ve sadece degisen kolonlar yazilir.
+func (h *Handler) PatchUserHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
 	}
 
-	return nil
+	var req UpdateUserRequest
+	if !api.BindPatch(c, updateRequestFrom(current), &req) {
+		return
+	}
+
+	u, err := h.repo.Patch(c.Request.Context(), id, current.Version, req.changes(current))
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "user.updated", u)
+}
+
+func (h *Handler) DeleteUserHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, ok := h.loadForWrite(c, id)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.Delete(c.Request.Context(), id, current.Version); err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "user.deleted", nil)
+}
+
+// RestoreUserHandler: soft delete edilmis kaydi geri alir. Diger yazma islemleri gibi If-Match zorunludur.
+func (h *Handler) RestoreUserHandler(c *gin.Context) {
+	id, ok := api.ParamID(c, "id")
+	if !ok {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, c.Param("id"))
+		return
+	}
+
+	current, err := h.repo.GetByID(c.Request.Context(), id, true)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+	if current.DeletedAt == nil {
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, id)
+		return
+	}
+	if !api.CheckIfMatch(c, current) {
+		return
+	}
+
+	u, err := h.repo.Restore(c.Request.Context(), id, current.Version)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return
+	}
+
+	api.SendSuccess(c, http.StatusOK, "user.restored", u)
+}
+
+// loadForWrite: guncelleme/silme oncesi kaydi okur ve If-Match header'ini kontrol eder (optimistic locking).
+// Okuma ile yazma arasindaki degisiklikler repository'deki version kosulu ile yakalanir.
+func (h *Handler) loadForWrite(c *gin.Context, id int64) (*User, bool) {
+	current, err := h.repo.GetByID(c.Request.Context(), id, false)
+	if err != nil {
+		sendRepositoryError(c, err, id)
+		return nil, false
+	}
+
+	return current, api.CheckIfMatch(c, current)
+}
+
+// sendRepositoryError: repository hatalarini HTTP cevabina cevirir.
+func sendRepositoryError(c *gin.Context, err error, id int64) {
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		api.SendError(c, http.StatusNotFound, "user.not_found", nil, id)
+	case errors.Is(err, database.ErrVersionMismatch):
+		api.SendError(c, http.StatusPreconditionFailed, "request.precondition_failed", nil)
+	case errors.Is(err, ErrEmailTaken):
+		api.SendError(c, http.StatusConflict, "user.email_taken", nil)
+	default:
+		// db hatasi, timeout hatasi vs gibi.
+		api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+	}
 }
@@ -0,0 +1,284 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"feature-base-starter-kit/pkg/api"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/tabular"
+	"feature-base-starter-kit/pkg/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// importColumns: baslik satirinda olmasi gereken kolonlar (buyuk/kucuk harf duyarsiz, sira onemsiz, fazlasi yok sayilir).
+var importColumns = []string{"name", "email", "age"}
+
+// ImportOptions: toplu yukleme sinirlari (bkz. config.ImportConfig).
+type ImportOptions struct {
+	MaxSize   int64 // byte
+	MaxRows   int   // baslik satiri ve bos satirlar haric
+	BatchSize int   // tek INSERT ile eklenen satir sayisi
+}
+
+// ImportReport: yukleme sonucu. Rows bos olmayan veri satirlarinin, Valid hatasiz satirlarin sayisidir.
+type ImportReport struct {
+	DryRun   bool       `json:"dry_run" xml:"dry_run" yaml:"dry_run"`
+	Rows     int        `json:"rows" xml:"rows" yaml:"rows"`
+	Valid    int        `json:"valid" xml:"valid" yaml:"valid"`
+	Imported int        `json:"imported" xml:"imported" yaml:"imported"`
+	Errors   []RowError `json:"errors" xml:"errors>row" yaml:"errors"`
+}
+
+// RowError: bir satirin alan hatalari. Row dosyadaki satir numarasidir (baslik satiri 1).
+type RowError struct {
+	Row    int          `json:"row" xml:"number,attr" yaml:"row"`
+	Fields []FieldError `json:"fields" xml:"fields>field" yaml:"fields"`
+}
+
+// FieldError: alanin cevrilmis hata mesajlari (validation.failed cevabindaki errors ile ayni mesajlar).
+type FieldError struct {
+	Field    string   `json:"field" xml:"field" yaml:"field"`
+	Messages []string `json:"messages" xml:"messages>message" yaml:"messages"`
+}
+
+// ImportUsersHandler: POST /users/import?dry_run=true. Govde CSV (text/csv), XLSX veya "file" alanli multipart olabilir;
+// ilk satir kolon basliklaridir (name, email, age). Her satir CreateUserRequest kurallariyla (async unique dahil)
+// dogrulanir, dosyada tekrar eden email'ler de hatadir. Hatasiz satirlar BatchSize'lik INSERT'lerle tek transaction'da
+// eklenir; hatali satirlar raporda doner ve eklenmez. dry_run ile sadece rapor uretilir.
+func (h *Handler) ImportUsersHandler(c *gin.Context) {
+	var req ImportUsersRequest
+	if !api.BindQuery(c, &req) {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.imports.MaxSize)
+	rows, ok := h.openImport(c)
+	if !ok {
+		return
+	}
+	defer rows.Close()
+
+	loc := i18n.FromContext(c)
+	header, err := rows.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.sendReadError(c, err)
+		return
+	}
+	columns, missing := columnIndex(header)
+	if len(missing) > 0 {
+		api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+			validation.Path("file"): {loc.T("user.import_missing_columns", strings.Join(missing, ", "))},
+		})
+		return
+	}
+
+	report := ImportReport{DryRun: req.DryRun, Errors: []RowError{}}
+	var users []User
+	seen := map[string]int{} // email -> ilk gecerli satir
+	for line := 2; ; line++ {
+		record, err := rows.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			h.sendReadError(c, err)
+			return
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		report.Rows++
+		if report.Rows > h.imports.MaxRows {
+			api.SendError(c, http.StatusRequestEntityTooLarge, "user.import_too_many_rows", nil, h.imports.MaxRows)
+			return
+		}
+
+		row := CreateUserRequest{Name: cell(record, columns["name"]), Email: cell(record, columns["email"])}
+		fields, err := validateImportRow(c, &row, cell(record, columns["age"]), seen)
+		if err != nil {
+			api.SendError(c, http.StatusInternalServerError, "server.internal_error", nil)
+			return
+		}
+		if len(fields) > 0 {
+			report.Errors = append(report.Errors, RowError{Row: line, Fields: fields})
+			continue
+		}
+
+		seen[strings.ToLower(row.Email)] = line
+		users = append(users, User{Name: row.Name, Email: row.Email, Age: row.Age, IsActive: true})
+	}
+	report.Valid = len(users)
+
+	if req.DryRun {
+		api.SendSuccess(c, http.StatusOK, "user.import_checked", report, report.Valid, report.Rows)
+		return
+	}
+
+	// dogrulama ile INSERT arasinda ayni email baska bir istekle eklenmisse tum yukleme geri alinir (409)
+	err = h.tx.WithinTx(c.Request.Context(), func(ctx context.Context) error {
+		for batch := range slices.Chunk(users, h.imports.BatchSize) {
+			if err := h.repo.CreateBatch(ctx, batch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		sendRepositoryError(c, err, 0)
+		return
+	}
+	report.Imported = len(users)
+
+	api.SendSuccess(c, http.StatusOK, "user.imported", report, report.Imported, report.Rows)
+}
+
+// openImport: istegin formatini Content-Type'tan (multipart ise "file" alaninin dosya adindan) bulur ve okuyucuyu acar.
+// Hata varsa cevabi gonderir ve false doner.
+func (h *Handler) openImport(c *gin.Context) (tabular.Reader, bool) {
+	var (
+		src    io.Reader = c.Request.Body
+		format tabular.Format
+		ok     bool
+	)
+
+	if c.ContentType() == binding.MIMEMultipartPOSTForm {
+		mr, err := c.Request.MultipartReader()
+		if err != nil {
+			api.SendError(c, http.StatusBadRequest, "request.invalid_payload", nil)
+			return nil, false
+		}
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				loc := i18n.FromContext(c)
+				api.SendError(c, http.StatusUnprocessableEntity, "validation.failed", map[string][]string{
+					validation.Path("file"): {loc.T("user.import_file_required")},
+				})
+				return nil, false
+			}
+			if err != nil {
+				h.sendReadError(c, err)
+				return nil, false
+			}
+			if part.FormName() == "file" {
+				src = part
+				if format, ok = tabular.FormatFromFilename(part.FileName()); !ok {
+					format, ok = tabular.FormatFromContentType(part.Header.Get("Content-Type"))
+				}
+				break
+			}
+		}
+	} else {
+		format, ok = tabular.FormatFromContentType(c.ContentType())
+	}
+
+	if !ok {
+		api.SendError(c, http.StatusUnsupportedMediaType, "user.import_unsupported_type", nil)
+		return nil, false
+	}
+
+	rows, err := tabular.NewReader(format, src, h.imports.MaxSize)
+	if err != nil {
+		h.sendReadError(c, err)
+		return nil, false
+	}
+	return rows, true
+}
+
+// sendReadError: dosya boyutu asildiysa 413, dosya okunamiyorsa (bozuk CSV/XLSX) 400.
+func (h *Handler) sendReadError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		api.SendError(c, http.StatusRequestEntityTooLarge, "user.import_too_large", nil, h.imports.MaxSize)
+		return
+	}
+	api.SendError(c, http.StatusBadRequest, "request.invalid_payload", nil)
+}
+
+// validateImportRow: age hucresini ve satiri CreateUserRequest kurallariyla dogrular, alan hatalarini istegin dilinde
+// dondurur. Async kurallar (unique) sadece diger kurallar gecerse calisir. Veritabani hatasi error olarak doner.
+func validateImportRow(c *gin.Context, row *CreateUserRequest, age string, seen map[string]int) ([]FieldError, error) {
+	loc := i18n.FromContext(c)
+	errs := map[string][]string{}
+
+	if age != "" {
+		n, err := strconv.Atoi(age)
+		if err != nil {
+			errs[validation.Path("age")] = []string{loc.T("user.import_invalid_number", "age")}
+		}
+		row.Age = n
+	}
+
+	var ve validator.ValidationErrors
+	if err := binding.Validator.ValidateStruct(row); errors.As(err, &ve) {
+		for field, msgs := range validation.MapValidationErrorsWith(ve, loc.Translator()) {
+			if _, ok := errs[field]; !ok {
+				errs[field] = msgs
+			}
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(errs) == 0 {
+		if first, ok := seen[strings.ToLower(row.Email)]; ok {
+			errs[validation.Path("email")] = []string{loc.T("user.import_duplicate_row", first)}
+		} else if err := validation.ValidateAsync(c.Request.Context(), row); errors.As(err, &ve) {
+			errs = validation.MapValidationErrorsWith(ve, loc.Translator())
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	fields := make([]FieldError, 0, len(errs))
+	for field, msgs := range errs {
+		fields = append(fields, FieldError{Field: field, Messages: msgs})
+	}
+	slices.SortFunc(fields, func(a, b FieldError) int { return strings.Compare(a.Field, b.Field) })
+	return fields, nil
+}
+
+// columnIndex: baslik satirindaki importColumns'larin indeksleri ve eksik kolonlar.
+func columnIndex(header []string) (map[string]int, []string) {
+	index := make(map[string]int, len(importColumns))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, dup := index[name]; !dup && slices.Contains(importColumns, name) {
+			index[name] = i
+		}
+	}
+
+	var missing []string
+	for _, name := range importColumns {
+		if _, ok := index[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return index, missing
+}
+
+func cell(record []string, i int) string {
+	if i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func isBlank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
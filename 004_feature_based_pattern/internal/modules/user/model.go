@@ -0,0 +1,25 @@
+package user
+
+import (
+	"time"
+
+	"feature-base-starter-kit/pkg/api"
+)
+
+// User: users tablosundaki bir kayit.
+type User struct {
+	ID        int64      `json:"id" xml:"id" yaml:"id"`
+	Name      string     `json:"name" xml:"name" yaml:"name"`
+	Email     string     `json:"email" xml:"email" yaml:"email"`
+	Age       int        `json:"age" xml:"age" yaml:"age"`
+	IsActive  bool       `json:"is_active" xml:"is_active" yaml:"is_active"`
+	Version   int64      `json:"version" xml:"version" yaml:"version"` // her guncellemede artar, ETag olarak gonderilir
+	CreatedAt time.Time  `json:"created_at" xml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" xml:"updated_at" yaml:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" xml:"deleted_at,omitempty" yaml:"deleted_at,omitempty"` // soft delete
+}
+
+// ETag: api.Versioned, bkz. api.CheckIfMatch.
+func (u User) ETag() string {
+	return api.VersionETag(u.Version)
+}
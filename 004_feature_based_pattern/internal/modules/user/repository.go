@@ -0,0 +1,292 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/database"
+)
+
+// ErrEmailTaken: email alaninda unique ihlali (async kontrolunden sonra yaris durumunda olusabilir).
+// Soft delete edilen kullanicilarin email'leri purge edilene kadar kullanimda sayilir.
+var ErrEmailTaken = errors.New("user: email already taken")
+
+// Repository: user modulunun veritabani islemleri. Bulunamayan kayitlar icin database.ErrNotFound doner.
+// Silinen (deleted_at dolu) kayitlar, withDeleted / ListOptions.WithDeleted verilmedikce sonuclara dahil edilmez.
+type Repository interface {
+	Create(ctx context.Context, u *User) error
+	// CreateBatch: kayitlari tek INSERT ile ekler (id'ler doldurulmaz), bkz. Handler.ImportUsersHandler.
+	CreateBatch(ctx context.Context, users []User) error
+	GetByID(ctx context.Context, id int64, withDeleted bool) (*User, error)
+	List(ctx context.Context, opts ListOptions) ([]User, int, error)
+	// Export: List'in filtreleriyle (Limit/Offset haric) tum kayitlari id sirasiyla cursor'dan okuyup fn'e verir.
+	// fn ayni baglantiyi kullanan sorgu calistirmamalidir (sqlite tek baglanti kullanir).
+	Export(ctx context.Context, opts ListOptions, fn func(*User) error) error
+	// Update: u.Version > 0 ise sadece veritabanindaki version ayniysa gunceller, degilse database.ErrVersionMismatch doner.
+	Update(ctx context.Context, u *User) error
+	// Patch: sadece changes'teki kolonlari (name, email, age, is_active) gunceller ve kaydin yeni halini dondurur.
+	// changes bos ise yazma yapilmaz. version Update'teki gibi kosulludur.
+	Patch(ctx context.Context, id, version int64, changes map[string]any) (*User, error)
+	// Delete: soft delete, kayit Restore ile geri alinabilir. version > 0 ise Update gibi kosulludur.
+	Delete(ctx context.Context, id, version int64) error
+	// Restore: version > 0 ise kosulludur; silinmis kayit varsa ama version degismisse database.ErrVersionMismatch doner.
+	Restore(ctx context.Context, id, version int64) (*User, error)
+	// Purge: before'dan once silinmis kayitlari kalici olarak siler; silinen ve hala makalesi oldugu icin
+	// atlanan kayit sayilarini dondurur.
+	Purge(ctx context.Context, before time.Time) (purged, skipped int64, err error)
+}
+
+// ListOptions: listeleme filtreleri.
+type ListOptions struct {
+	Limit       int
+	Offset      int
+	WithDeleted bool
+}
+
+type sqlRepository struct {
+	db *database.DB
+}
+
+// NewRepository: db'nin dialect'ine gore (postgres, mysql, sqlite) calisan repository.
+func NewRepository(db *database.DB) Repository {
+	return &sqlRepository{db: db}
+}
+
+const userColumns = "id, name, email, age, is_active, version, created_at, updated_at, deleted_at"
+
+func scanUser(row interface{ Scan(...any) error }) (*User, error) {
+	var (
+		u         User
+		deletedAt sql.NullTime
+	)
+	if err := row.Scan(&u.ID, &u.Name, &u.Email, &u.Age, &u.IsActive, &u.Version, &u.CreatedAt, &u.UpdatedAt, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	if deletedAt.Valid {
+		u.DeletedAt = &deletedAt.Time
+	}
+	return &u, nil
+}
+
+// notDeleted: withDeleted false ise sorguya eklenecek kosul.
+func notDeleted(withDeleted bool) string {
+	if withDeleted {
+		return ""
+	}
+	return " AND deleted_at IS NULL"
+}
+
+// Create: kaydi ekler ve u'yu veritabanindaki haliyle (id, created_at...) gunceller.
+func (r *sqlRepository) Create(ctx context.Context, u *User) error {
+	id, err := r.db.Insert(ctx, "INSERT INTO users (name, email, age, is_active) VALUES (?, ?, ?, ?)",
+		u.Name, u.Email, u.Age, u.IsActive)
+	if err != nil {
+		return r.mapError(err)
+	}
+
+	return r.reload(ctx, id, u)
+}
+
+func (r *sqlRepository) CreateBatch(ctx context.Context, users []User) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(users))
+	args := make([]any, 0, len(users)*4)
+	for i, u := range users {
+		values[i] = "(" + database.Placeholders(4) + ")"
+		args = append(args, u.Name, u.Email, u.Age, u.IsActive)
+	}
+
+	_, err := r.db.ExecContext(ctx, "INSERT INTO users (name, email, age, is_active) VALUES "+strings.Join(values, ", "), args...)
+	return r.mapError(err)
+}
+
+func (r *sqlRepository) GetByID(ctx context.Context, id int64, withDeleted bool) (*User, error) {
+	u, err := scanUser(r.db.QueryRowContext(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?"+notDeleted(withDeleted), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, database.ErrNotFound
+	}
+	return u, err
+}
+
+// List: kayitlari id sirasina gore dondurur, ikinci deger toplam kayit sayisidir.
+func (r *sqlRepository) List(ctx context.Context, opts ListOptions) ([]User, int, error) {
+	where := ""
+	if !opts.WithDeleted {
+		where = " WHERE deleted_at IS NULL"
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users"+where).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+userColumns+" FROM users"+where+" ORDER BY id LIMIT ? OFFSET ?", opts.Limit, opts.Offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, *u)
+	}
+
+	return users, total, rows.Err()
+}
+
+func (r *sqlRepository) Export(ctx context.Context, opts ListOptions, fn func(*User) error) error {
+	where := ""
+	if !opts.WithDeleted {
+		where = " WHERE deleted_at IS NULL"
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+userColumns+" FROM users"+where+" ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Update: updated_at veritabaninda guncellenir (postgres: trigger, mysql: ON UPDATE), bu yuzden kayit tekrar okunur.
+// Silinmis kullanicilar guncellenmez (database.ErrNotFound).
+func (r *sqlRepository) Update(ctx context.Context, u *User) error {
+	q, args := database.WithVersion("UPDATE users SET name = ?, email = ?, age = ?, is_active = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL",
+		u.Version, u.Name, u.Email, u.Age, u.IsActive, u.ID)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return r.mapError(err)
+	}
+	if err := r.checkAffected(ctx, res, u.ID); err != nil {
+		return err
+	}
+
+	return r.reload(ctx, u.ID, u)
+}
+
+func (r *sqlRepository) Patch(ctx context.Context, id, version int64, changes map[string]any) (*User, error) {
+	if len(changes) == 0 {
+		return r.GetByID(ctx, id, false)
+	}
+
+	set, args := r.db.SetClause(changes)
+	q, args := database.WithVersion("UPDATE users SET "+set+", version = version + 1 WHERE id = ? AND deleted_at IS NULL",
+		version, append(args, id)...)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, r.mapError(err)
+	}
+	if err := r.checkAffected(ctx, res, id); err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, id, false)
+}
+
+// Delete: deleted_at uygulama tarafinda (UTC) verilir, boylece Purge'daki karsilastirma veritabaninin saat diliminden etkilenmez.
+func (r *sqlRepository) Delete(ctx context.Context, id, version int64) error {
+	q, args := database.WithVersion("UPDATE users SET deleted_at = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL",
+		version, time.Now().UTC(), id)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	return r.checkAffected(ctx, res, id)
+}
+
+// Restore: sadece silinmis bir kayit geri alinabilir, aksi halde database.ErrNotFound doner.
+func (r *sqlRepository) Restore(ctx context.Context, id, version int64) (*User, error) {
+	q, args := database.WithVersion("UPDATE users SET deleted_at = NULL, version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL",
+		version, id)
+
+	res, err := r.db.ExecContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		// Silinmis kayit var ama version degismis
+		if version > 0 {
+			if current, err := r.GetByID(ctx, id, true); err == nil && current.DeletedAt != nil {
+				return nil, database.ErrVersionMismatch
+			}
+		}
+		return nil, database.ErrNotFound
+	}
+	return r.GetByID(ctx, id, false)
+}
+
+// Purge: makalesi (silinmis olsa bile) olan kullanicilar silinmez, aksi halde articles.user_id ON DELETE SET NULL ile
+// bosalir ve makaleler sahipsiz kalir. Bu kullanicilar makaleleri purge edildikten sonraki calistirmada silinir.
+func (r *sqlRepository) Purge(ctx context.Context, before time.Time) (int64, int64, error) {
+	const expired = "deleted_at IS NOT NULL AND deleted_at < ?"
+
+	res, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE "+expired+
+		" AND NOT EXISTS (SELECT 1 FROM articles a WHERE a.user_id = users.id)", before.UTC())
+	if err != nil {
+		return 0, 0, err
+	}
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var skipped int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users WHERE "+expired, before.UTC()).Scan(&skipped); err != nil {
+		return 0, 0, err
+	}
+	return purged, skipped, nil
+}
+
+// checkAffected: hicbir satir guncellenmediyse kaydin olmadigini veya version'inin degistigini ayirt eder.
+func (r *sqlRepository) checkAffected(ctx context.Context, res sql.Result, id int64) error {
+	n, err := res.RowsAffected()
+	if err != nil || n > 0 {
+		return err
+	}
+
+	if _, err := r.GetByID(ctx, id, false); err != nil {
+		return err
+	}
+	return database.ErrVersionMismatch
+}
+
+func (r *sqlRepository) reload(ctx context.Context, id int64, u *User) error {
+	fresh, err := r.GetByID(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	*u = *fresh
+	return nil
+}
+
+func (r *sqlRepository) mapError(err error) error {
+	if r.db.Dialect.IsUniqueViolation(err) {
+		return ErrEmailTaken
+	}
+	return err
+}
@@ -1,7 +1,45 @@
 package user
 
 type CreateUserRequest struct {
-	Name  string `json:"name" binding:"required,min=2,max=100"` // Kullanimi : `` arasina binding kurallari yazilir
-	Email string `json:"email" binding:"required,email"`
+	Name  string `json:"name" binding:"required,min=2,max=100"`                     // Kullanimi : `` arasina binding kurallari yazilir
+	Email string `json:"email" binding:"required,email" async:"unique=users.email"` // async: DB gerektiren kurallar, bkz. validation.ValidateAsync
 	Age   int    `json:"age" binding:"required"`
 }
+
+// UpdateUserRequest: kaydin tamamini degistirir (PUT). Email'in baska bir kullanicida olup olmadigi
+// async kural yerine veritabaninin unique kisitiyla kontrol edilir (kullanicinin kendi email'i de tabloda oldugu icin).
+type UpdateUserRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=100"`
+	Email    string `json:"email" binding:"required,email"`
+	Age      int    `json:"age" binding:"required"`
+	IsActive *bool  `json:"is_active" binding:"required"`
+}
+
+// updateRequestFrom: PATCH'in uygulanacagi belge, kaydin PUT DTO'su seklindeki guncel hali.
+func updateRequestFrom(u *User) UpdateUserRequest {
+	isActive := u.IsActive
+	return UpdateUserRequest{Name: u.Name, Email: u.Email, Age: u.Age, IsActive: &isActive}
+}
+
+// changes: patch uygulanmis istegin u'dan farkli olan kolonlari (sadece bunlar yazilir).
+func (req UpdateUserRequest) changes(u *User) map[string]any {
+	c := map[string]any{}
+	if req.Name != u.Name {
+		c["name"] = req.Name
+	}
+	if req.Email != u.Email {
+		c["email"] = req.Email
+	}
+	if req.Age != u.Age {
+		c["age"] = req.Age
+	}
+	if *req.IsActive != u.IsActive {
+		c["is_active"] = *req.IsActive
+	}
+	return c
+}
+
+// ImportUsersRequest: POST /users/import query parametreleri.
+type ImportUsersRequest struct {
+	DryRun bool `form:"dry_run"` // satirlar dogrulanir, kayit eklenmez
+}
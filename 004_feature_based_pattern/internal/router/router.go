@@ -1,21 +1,151 @@
 package router
 
 import (
+	"cmp"
 	"feature-base-starter-kit/internal/config"
 	"feature-base-starter-kit/internal/middleware"
+	"feature-base-starter-kit/internal/modules/article"
+	"feature-base-starter-kit/internal/modules/category"
+	"feature-base-starter-kit/internal/modules/comment"
+	"feature-base-starter-kit/internal/modules/feed"
+	"feature-base-starter-kit/internal/modules/tag"
+	"feature-base-starter-kit/internal/modules/upload"
 	"feature-base-starter-kit/internal/modules/user"
+	"feature-base-starter-kit/pkg/auth"
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/health"
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/storage"
 
 	"github.com/gin-gonic/gin"
 )
 
-func Setup(cfg *config.Config) *gin.Engine {
+func Setup(cfg *config.Config, db *database.DB, store storage.Storage) *gin.Engine {
 	r := gin.Default()
 	// r.Use(mid1, mid2) // Global Middleware eklenebilir
-	r.Use(middleware.LoggerMiddleware())
+	if cfg.Log.Requests {
+		r.Use(middleware.LoggerMiddleware())
+	}
+	r.Use(i18n.Middleware()) // istegin dilini belirler (?lang=, Accept-Language)
+
+	// Kubernetes / docker-compose saglik kontrolleri (auth gerektirmez)
+	r.GET("/health/live", health.LiveHandler)
+	r.GET("/health/ready", health.ReadyHandler)
+
+	// RSS/Atom feed'leri ve sitemap (auth gerektirmez, sadece yayinlanmis makaleler)
+	feeds := feed.NewHandler(feed.NewRepository(db), feed.Site{
+		URL:          cfg.Site.URL,
+		Title:        cfg.Site.Title,
+		Description:  cfg.Site.Description,
+		Lang:         cfg.I18n.Lang,
+		ArticlePath:  cfg.Site.ArticlePath,
+		CategoryPath: cfg.Site.CategoryPath,
+		FeedLimit:    cfg.Site.FeedLimit,
+		CacheMaxAge:  cfg.Site.CacheMaxAge,
+	})
+	for path, handler := range map[string]gin.HandlerFunc{
+		"/feed.xml":                  feeds.RSSHandler,
+		"/atom.xml":                  feeds.AtomHandler,
+		"/sitemap.xml":               feeds.SitemapHandler,
+		"/categories/:slug/feed.xml": feeds.RSSHandler,
+		"/categories/:slug/atom.xml": feeds.AtomHandler,
+	} {
+		r.GET(path, handler)
+		r.HEAD(path, handler) // feed okuyucular ve crawler'lar degisiklik kontrolu icin HEAD kullanabilir
+	}
 
 	protectedRoute := r.Group("/api")
-	protectedRoute.Use(middleware.AuthMiddleware(cfg.API_SECRET_KEY))
-	protectedRoute.POST("/users", user.CreateUserHandler)
+	protectedRoute.Use(middleware.AuthMiddleware(cfg.Auth.Keys()))
+
+	// Her modul kendi repository'si ile olusturulur, repository db'nin dialect'ine gore sorgu uretir.
+	// tx: birden fazla tabloya yazan handler'lar icin (unit of work)
+	tx := database.NewTxManager(db)
+
+	// yuklenen gorseller /files altinda imzali ve sureli URL'lerle sunulur (auth gerektirmez, bkz. storage.Signer)
+	signer := storage.NewSigner(cmp.Or(cfg.Upload.URLSecret.Value(), cfg.Auth.APISecretKey.Value()), cfg.Upload.URLTTL, cfg.Upload.BaseURL)
+	uploads := upload.NewHandler(upload.NewRepository(db), tx, store, signer, upload.Options{
+		MaxSize:        cfg.Upload.MaxSize,
+		MinDimension:   cfg.Upload.MinDimension,
+		MaxDimension:   cfg.Upload.MaxDimension,
+		ThumbnailSizes: cfg.Upload.ThumbnailSizes,
+	})
+	r.GET("/files/*key", uploads.ServeFileHandler)
+	r.HEAD("/files/*key", uploads.ServeFileHandler)
+
+	users := user.NewHandler(user.NewRepository(db), tx, user.ImportOptions{
+		MaxSize:   cfg.Import.MaxSize,
+		MaxRows:   cfg.Import.MaxRows,
+		BatchSize: cfg.Import.BatchSize,
+	})
+	// kullanici olusturma, degistirme, import ve export sadece admin; okuma tum rollere acik
+	userAdmin := protectedRoute.Group("/users", middleware.RequireRole(auth.RoleAdmin))
+	protectedRoute.GET("/users", users.ListUsersHandler)
+	userAdmin.POST("", users.CreateUserHandler)
+	userAdmin.POST("/import", users.ImportUsersHandler) // CSV veya XLSX, ?dry_run=true
+	userAdmin.GET("/export", users.ExportUsersHandler)  // Accept: CSV, NDJSON veya XLSX
+	protectedRoute.GET("/users/:id", users.GetUserHandler)
+	userAdmin.PUT("/:id", users.UpdateUserHandler)
+	userAdmin.PATCH("/:id", users.PatchUserHandler)   // merge patch veya JSON patch
+	userAdmin.DELETE("/:id", users.DeleteUserHandler) // soft delete
+	userAdmin.POST("/:id/restore", users.RestoreUserHandler)
+	userAdmin.PUT("/:id/avatar", uploads.PutHandler(upload.KindAvatar)) // multipart, "file" alani
+	protectedRoute.GET("/users/:id/avatar", uploads.GetHandler(upload.KindAvatar))
+	userAdmin.DELETE("/:id/avatar", uploads.DeleteHandler(upload.KindAvatar))
+
+	categories := category.NewHandler(category.NewRepository(db))
+	protectedRoute.GET("/categories", categories.ListCategoriesHandler)
+	protectedRoute.POST("/categories", categories.CreateCategoryHandler)
+	protectedRoute.GET("/categories/tree", categories.CategoryTreeHandler)           // ?active=true
+	protectedRoute.GET("/categories/breadcrumb/:slug", categories.BreadcrumbHandler) // kokten kategoriye yol
+	protectedRoute.GET("/categories/:id", categories.GetCategoryHandler)
+	protectedRoute.PUT("/categories/:id", categories.UpdateCategoryHandler)
+	protectedRoute.DELETE("/categories/:id", categories.DeleteCategoryHandler)
+
+	// description HTML'i icin allow-list, bkz. config.HTMLConfig
+	sanitizer := article.NewSanitizer(article.SanitizeOptions{
+		Tags:          cfg.HTML.Tags,
+		Attributes:    cfg.HTML.Attributes,
+		URLSchemes:    cfg.HTML.URLSchemes,
+		Reject:        cfg.HTML.Mode == "reject",
+		ExcerptLength: cfg.HTML.ExcerptLength,
+	})
+	articles := article.NewHandler(article.NewRepository(db), tx, sanitizer)
+	protectedRoute.GET("/articles", articles.ListArticlesHandler) // ?category_id=&include_descendants=true&tag=
+	protectedRoute.POST("/articles", articles.CreateArticleHandler)
+	protectedRoute.GET("/articles/search", articles.SearchArticlesHandler) // ?q=
+	protectedRoute.GET("/articles/export", articles.ExportArticlesHandler) // GET /articles filtreleri, Accept: CSV, NDJSON veya XLSX
+	protectedRoute.GET("/articles/:id", articles.GetArticleHandler)
+	protectedRoute.PUT("/articles/:id", articles.UpdateArticleHandler)
+	protectedRoute.PATCH("/articles/:id", articles.PatchArticleHandler)
+	protectedRoute.DELETE("/articles/:id", articles.DeleteArticleHandler) // soft delete
+	protectedRoute.POST("/articles/:id/restore", articles.RestoreArticleHandler)
+	protectedRoute.POST("/articles/:id/transitions", articles.TransitionArticleHandler) // yayin akisi, bkz. article/workflow.go
+	protectedRoute.GET("/articles/:id/history", articles.ArticleHistoryHandler)
+	protectedRoute.PUT("/articles/:id/cover", uploads.PutHandler(upload.KindCover)) // multipart, "file" alani
+	protectedRoute.GET("/articles/:id/cover", uploads.GetHandler(upload.KindCover))
+	protectedRoute.DELETE("/articles/:id/cover", uploads.DeleteHandler(upload.KindCover))
+
+	// okuyucu yorumlari sadece yayinlanmis makalelerde; moderasyon kuyrugu ve islemleri sadece admin
+	comments := comment.NewHandler(comment.NewRepository(db), comment.Options{
+		AutoApprove: cfg.Comment.AutoApprove,
+		BannedWords: cfg.Comment.BannedWords,
+		MaxLinks:    cfg.Comment.MaxLinks,
+		IPRateLimit: cfg.Comment.IPRateLimit,
+		RateWindow:  cfg.Comment.RateWindow,
+	})
+	protectedRoute.GET("/articles/:id/comments", comments.ListArticleCommentsHandler) // onayli yorumlar, thread olarak
+	protectedRoute.POST("/articles/:id/comments", comments.CreateCommentHandler)
+	moderation := protectedRoute.Group("/comments", middleware.RequireRole(auth.RoleAdmin))
+	moderation.GET("", comments.ModerationQueueHandler) // ?status=pending|approved|spam&article_id=
+	moderation.POST("/:id/approve", comments.ApproveCommentHandler)
+	moderation.POST("/:id/spam", comments.SpamCommentHandler)
+	moderation.DELETE("/:id", comments.DeleteCommentHandler)
+
+	// etiketler makale yazilirken olusturulur (article tags alani), burada sadece okuma endpoint'leri var
+	tags := tag.NewHandler(tag.NewRepository(db))
+	protectedRoute.GET("/tags/popular", tags.PopularTagsHandler)           // ?limit=
+	protectedRoute.GET("/tags/autocomplete", tags.AutocompleteTagsHandler) // ?q=&limit=
+	protectedRoute.GET("/tags/:slug/articles", articles.ArticlesByTagHandler)
 
 	//r.POST("/users", user.CreateUserHandler)
 
@@ -0,0 +1,9 @@
+// Package migrations: veritabani semasi, her dialect icin ayri bir klasorde (postgres, mysql, sqlite).
+// Dosyalar NNN_name.up.sql formatinda isimlendirilir ve isim sirasina gore calistirilir (bkz. database.Migrate).
+// Bir dialect'te karsiligi olmayan migration o klasorde bulunmaz (orn: sqlite'ta 004_search yok, arama bellekteki indeksle yapilir).
+package migrations
+
+import "embed"
+
+//go:embed postgres/*.sql mysql/*.sql sqlite/*.sql
+var FS embed.FS
@@ -0,0 +1,3 @@
+-- Soft delete: silinen kayitlar deleted_at ile isaretlenir, purge komutu saklama suresi dolanlari kalici olarak siler.
+ALTER TABLE users ADD COLUMN deleted_at DATETIME(6) NULL, ADD INDEX idx_users_deleted_at (deleted_at);
+ALTER TABLE articles ADD COLUMN deleted_at DATETIME(6) NULL, ADD INDEX idx_articles_deleted_at (deleted_at);
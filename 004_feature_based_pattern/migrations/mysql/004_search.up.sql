@@ -0,0 +1,2 @@
+-- Tam metin arama (MATCH ... AGAINST). MySQL HTML etiketlerini temizlemez; snippet'ler uygulamada uretilir.
+ALTER TABLE articles ADD FULLTEXT INDEX ft_articles_search (title, short_description, description);
@@ -0,0 +1,23 @@
+-- Yayin akisi: draft -> review -> scheduled/published -> archived (gecisler icin bkz. internal/modules/article/workflow.go).
+-- published_at: yayin zamani, scheduled durumunda planlanan zaman. is_active geriye uyumluluk icin korunur;
+-- mevcut aktif makaleler yayinlanmis, digerleri taslak kabul edilir.
+ALTER TABLE articles
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD COLUMN published_at DATETIME(6) NULL,
+    ADD CONSTRAINT chk_articles_status CHECK (status IN ('draft', 'review', 'scheduled', 'published', 'archived')),
+    ADD INDEX idx_articles_status_published_at (status, published_at);
+
+UPDATE articles SET status = 'published', published_at = created_at WHERE is_active;
+
+-- Her durum gecisi kaydedilir. actor: gecisi yapan rol (author, editor, admin) veya scheduler icin system.
+CREATE TABLE IF NOT EXISTS article_status_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(20) NOT NULL,
+    note VARCHAR(500),
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_article_status_history_article_id (article_id),
+    CONSTRAINT fk_article_status_history_article FOREIGN KEY (article_id) REFERENCES articles (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,8 @@
+-- Markdown ile yazilan makaleler: description render edilmis (sanitize edilmis) HTML olarak kalir, boylece arama, ozet ve
+-- okuma tarafi formattan bagimsizdir. description_source duzenleme icin markdown kaynagi (html formatinda NULL),
+-- toc basliklardan uretilen icindekiler listesi (bkz. pkg/markdown).
+ALTER TABLE articles
+    ADD COLUMN description_format VARCHAR(10) NOT NULL DEFAULT 'html',
+    ADD COLUMN description_source TEXT,
+    ADD COLUMN toc JSON,
+    ADD CONSTRAINT chk_articles_description_format CHECK (description_format IN ('html', 'markdown'));
@@ -0,0 +1,7 @@
+-- Alt kategoriler: parent_id NULL ise kok kategori. Ust kategori silinirse alt kategoriler koke tasinir.
+-- Dongu (bir kategorinin kendi alt kategorisinin altina tasinmasi) uygulamada engellenir, bkz. category.Repository.
+-- MySQL, ON DELETE SET NULL kullanan kolonlarda CHECK kisitina izin vermez; parent_id <> id de uygulamada kontrol edilir.
+ALTER TABLE categories
+    ADD COLUMN parent_id BIGINT NULL,
+    ADD INDEX idx_categories_parent_id (parent_id),
+    ADD CONSTRAINT fk_categories_parent FOREIGN KEY (parent_id) REFERENCES categories (id) ON DELETE SET NULL;
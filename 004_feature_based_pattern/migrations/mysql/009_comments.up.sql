@@ -0,0 +1,24 @@
+-- Okuyucu yorumlari: parent_id ile ic ice (thread), ust yorum silinirse cevaplari da silinir.
+-- Yeni yorumlar pending (moderasyon kuyrugu) veya spam puanina gore spam olarak eklenir, sadece approved yorumlar gosterilir.
+-- user_id kayitli kullanicilar icin (silinirse yorum author_name ile kalir), ip hiz siniri ve moderasyon icin saklanir.
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    article_id BIGINT NOT NULL,
+    parent_id BIGINT NULL,
+    user_id BIGINT NULL,
+    author_name VARCHAR(100) NOT NULL,
+    author_email VARCHAR(100),
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending',
+    spam_score DOUBLE NOT NULL DEFAULT 0,
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    INDEX idx_comments_article_id_status (article_id, status),
+    INDEX idx_comments_status_created_at (status, created_at),
+    INDEX idx_comments_parent_id (parent_id),
+    CONSTRAINT chk_comments_status CHECK (status IN ('pending', 'approved', 'spam')),
+    CONSTRAINT fk_comments_article FOREIGN KEY (article_id) REFERENCES articles (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comments_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+-- Yuklenen gorseller: kullanici avatarlari (kind=avatar, owner_id=users.id) ve makale kapaklari (kind=cover,
+-- owner_id=articles.id). Her sahibin her turden tek gorseli olur, yeni yukleme eskisinin yerine gecer.
+-- Dosyalar Storage'da (bkz. pkg/storage) saklanir; storage_key orijinal dosya, thumbnails {"<boyut>": "<key>"} JSON'u.
+CREATE TABLE IF NOT EXISTS uploads (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    kind VARCHAR(10) NOT NULL,
+    owner_id BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    thumbnails TEXT NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    UNIQUE KEY uq_uploads_kind_owner_id (kind, owner_id),
+    CONSTRAINT chk_uploads_kind CHECK (kind IN ('avatar', 'cover'))
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+-- Optimistic concurrency: her guncellemede bir artar, ETag olarak disari acilir (If-Match ile kosullu guncelleme).
+ALTER TABLE users ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE articles ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,16 @@
+-- Tam metin arama: title (A), short_description (B) ve HTML etiketleri temizlenmis description (C) agirlikli indekslenir.
+-- Turkce istekler icin turkish (kok bulma), digerleri icin simple config'i kullanilir; her biri icin ayri generated kolon ve GIN indeksi.
+ALTER TABLE articles ADD COLUMN search_tr tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('turkish', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('turkish', coalesce(short_description, '')), 'B') ||
+    setweight(to_tsvector('turkish', regexp_replace(description, '<[^>]*>', ' ', 'g')), 'C')
+) STORED;
+
+ALTER TABLE articles ADD COLUMN search_simple tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(short_description, '')), 'B') ||
+    setweight(to_tsvector('simple', regexp_replace(description, '<[^>]*>', ' ', 'g')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_articles_search_tr ON articles USING GIN (search_tr);
+CREATE INDEX IF NOT EXISTS idx_articles_search_simple ON articles USING GIN (search_simple);
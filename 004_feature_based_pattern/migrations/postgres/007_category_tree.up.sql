@@ -0,0 +1,6 @@
+-- Alt kategoriler: parent_id NULL ise kok kategori. Ust kategori silinirse alt kategoriler koke tasinir.
+-- Dongu (bir kategorinin kendi alt kategorisinin altina tasinmasi) uygulamada engellenir, bkz. category.Repository.
+ALTER TABLE categories ADD COLUMN parent_id BIGINT REFERENCES categories (id) ON DELETE SET NULL
+    CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
@@ -0,0 +1,23 @@
+-- Okuyucu yorumlari: parent_id ile ic ice (thread), ust yorum silinirse cevaplari da silinir.
+-- Yeni yorumlar pending (moderasyon kuyrugu) veya spam puanina gore spam olarak eklenir, sadece approved yorumlar gosterilir.
+-- user_id kayitli kullanicilar icin (silinirse yorum author_name ile kalir), ip hiz siniri ve moderasyon icin saklanir.
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    article_id BIGINT NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    parent_id BIGINT REFERENCES comments (id) ON DELETE CASCADE,
+    user_id BIGINT REFERENCES users (id) ON DELETE SET NULL,
+    author_name VARCHAR(100) NOT NULL,
+    author_email VARCHAR(100),
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'spam')),
+    spam_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_article_id_status ON comments (article_id, status);
+CREATE INDEX IF NOT EXISTS idx_comments_status_created_at ON comments (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+
+CREATE TRIGGER trg_comments_updated BEFORE UPDATE ON comments FOR EACH ROW EXECUTE FUNCTION update_updated_at();
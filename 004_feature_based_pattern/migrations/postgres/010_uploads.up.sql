@@ -0,0 +1,16 @@
+-- Yuklenen gorseller: kullanici avatarlari (kind=avatar, owner_id=users.id) ve makale kapaklari (kind=cover,
+-- owner_id=articles.id). Her sahibin her turden tek gorseli olur, yeni yukleme eskisinin yerine gecer.
+-- Dosyalar Storage'da (bkz. pkg/storage) saklanir; storage_key orijinal dosya, thumbnails {"<boyut>": "<key>"} JSON'u.
+CREATE TABLE IF NOT EXISTS uploads (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('avatar', 'cover')),
+    owner_id BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    content_type VARCHAR(50) NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    thumbnails TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (kind, owner_id)
+);
@@ -0,0 +1,6 @@
+-- Soft delete: silinen kayitlar deleted_at ile isaretlenir, purge komutu saklama suresi dolanlari kalici olarak siler.
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMP NULL;
+ALTER TABLE articles ADD COLUMN deleted_at TIMESTAMP NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_articles_deleted_at ON articles (deleted_at);
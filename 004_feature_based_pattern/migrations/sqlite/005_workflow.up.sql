@@ -0,0 +1,24 @@
+-- Yayin akisi: draft -> review -> scheduled/published -> archived (gecisler icin bkz. internal/modules/article/workflow.go).
+-- published_at: yayin zamani, scheduled durumunda planlanan zaman. is_active geriye uyumluluk icin korunur;
+-- mevcut aktif makaleler yayinlanmis, digerleri taslak kabul edilir.
+ALTER TABLE articles ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft'
+    CHECK (status IN ('draft', 'review', 'scheduled', 'published', 'archived'));
+ALTER TABLE articles ADD COLUMN published_at TIMESTAMP NULL;
+
+UPDATE articles SET status = 'published', published_at = created_at WHERE is_active;
+
+-- scheduler: status = 'scheduled' AND published_at <= now
+CREATE INDEX IF NOT EXISTS idx_articles_status_published_at ON articles (status, published_at);
+
+-- Her durum gecisi kaydedilir. actor: gecisi yapan rol (author, editor, admin) veya scheduler icin system.
+CREATE TABLE IF NOT EXISTS article_status_history (
+    id INTEGER PRIMARY KEY,
+    article_id INTEGER NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    actor VARCHAR(20) NOT NULL,
+    note VARCHAR(500),
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_status_history_article_id ON article_status_history (article_id);
@@ -0,0 +1,16 @@
+-- Etiketler: yazarlarin serbestce ekledigi, kategorilerden bagimsiz etiketler. Makale yazilirken olmayan etiketler
+-- olusturulur; slug pkg/slug ile isimden uretilir ve etiketin kimligidir ("Go Lang" ve "go-lang" ayni etikettir).
+CREATE TABLE IF NOT EXISTS tags (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    slug VARCHAR(60) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE TABLE IF NOT EXISTS article_tags (
+    article_id INTEGER NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (article_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_article_tags_tag_id ON article_tags (tag_id);
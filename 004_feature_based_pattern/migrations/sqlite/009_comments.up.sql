@@ -0,0 +1,26 @@
+-- Okuyucu yorumlari: parent_id ile ic ice (thread), ust yorum silinirse cevaplari da silinir.
+-- Yeni yorumlar pending (moderasyon kuyrugu) veya spam puanina gore spam olarak eklenir, sadece approved yorumlar gosterilir.
+-- user_id kayitli kullanicilar icin (silinirse yorum author_name ile kalir), ip hiz siniri ve moderasyon icin saklanir.
+CREATE TABLE IF NOT EXISTS comments (
+    id INTEGER PRIMARY KEY,
+    article_id INTEGER NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES comments (id) ON DELETE CASCADE,
+    user_id INTEGER REFERENCES users (id) ON DELETE SET NULL,
+    author_name VARCHAR(100) NOT NULL,
+    author_email VARCHAR(100),
+    body TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'spam')),
+    spam_score REAL NOT NULL DEFAULT 0,
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    updated_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_article_id_status ON comments (article_id, status);
+CREATE INDEX IF NOT EXISTS idx_comments_status_created_at ON comments (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+
+CREATE TRIGGER IF NOT EXISTS trg_comments_updated AFTER UPDATE ON comments FOR EACH ROW
+BEGIN
+    UPDATE comments SET updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now') WHERE id = NEW.id;
+END;
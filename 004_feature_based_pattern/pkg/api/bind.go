@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"feature-base-starter-kit/pkg/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// BindJSON: istegi obj'ye bind eder ve binding kurallarini calistirir.
+// Hata varsa cevabi (422 veya 400) gonderir ve false doner.
+func BindJSON(ctx *gin.Context, obj any) bool {
+	err := ctx.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		SendValidationError(ctx, ve)
+		return false
+	}
+
+	SendError(ctx, http.StatusBadRequest, "request.invalid_payload", nil)
+	return false
+}
+
+// BindQuery: query parametrelerini obj'ye (form tag'leri) bind eder, hata varsa BindJSON gibi cevabi gonderir.
+func BindQuery(ctx *gin.Context, obj any) bool {
+	err := ctx.ShouldBindQuery(obj)
+	if err == nil {
+		return true
+	}
+
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		SendValidationError(ctx, ve)
+		return false
+	}
+
+	SendError(ctx, http.StatusBadRequest, "request.invalid_payload", nil)
+	return false
+}
+
+// ValidateAsync: obj uzerindeki async kurallari (unique, exists) request context'i ile calistirir.
+// Hata varsa cevabi (422 veya 500) gonderir ve false doner.
+func ValidateAsync(ctx *gin.Context, obj any) bool {
+	err := validation.ValidateAsync(ctx.Request.Context(), obj)
+	if err == nil {
+		return true
+	}
+
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		SendValidationError(ctx, ve)
+		return false
+	}
+
+	SendError(ctx, http.StatusInternalServerError, "server.internal_error", nil)
+	return false
+}
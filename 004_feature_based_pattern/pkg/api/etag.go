@@ -0,0 +1,93 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"feature-base-starter-kit/pkg/i18n"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Versioned: ETag'i olan kaynaklar (orn: version kolonu olan user ve article).
+// SendSuccess, data Versioned ise ETag header'ini ekler ve If-None-Match ile kosullu GET'i destekler.
+type Versioned interface {
+	ETag() string
+}
+
+// VersionETag: version numarasindan strong ETag uretir: "3"
+func VersionETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// representationETag: ayni version'in JSON/XML/YAML ve her dildeki govdesi farkli oldugundan
+// gonderilen ETag'e format ve dil eklenir: "3-json-en". If-Match sadece version kismina bakar, bkz. CheckIfMatch.
+func representationETag(ctx *gin.Context, etag string) string {
+	format := ctx.Query("format")
+	switch format {
+	case "xml", "yaml":
+	case "yml":
+		format = "yaml"
+	default:
+		format = "json"
+	}
+
+	return strings.TrimSuffix(etag, `"`) + "-" + format + "-" + i18n.FromContext(ctx).Lang() + `"`
+}
+
+// CheckIfMatch: PUT/PATCH/DELETE oncesi If-Match header'ini kaynagin guncel ETag'i ile karsilastirir.
+// Header yoksa 428 Precondition Required, eslesmezse 412 Precondition Failed gonderir ve false doner.
+// Herhangi bir gosterimin ETag'i ("3-xml-tr") veya yalin version ("3") kabul edilir.
+func CheckIfMatch(ctx *gin.Context, current Versioned) bool {
+	header := ctx.GetHeader("If-Match")
+	if header == "" {
+		SendError(ctx, http.StatusPreconditionRequired, "request.precondition_required", nil)
+		return false
+	}
+
+	// If-Match strong karsilastirma kullanir: W/ ile baslayan ETag'ler eslesmez (RFC 9110 13.1.1)
+	if !matchETag(header, current.ETag(), false, true) {
+		ctx.Header("ETag", representationETag(ctx, current.ETag()))
+		SendError(ctx, http.StatusPreconditionFailed, "request.precondition_failed", nil)
+		return false
+	}
+	return true
+}
+
+// notModified: GET/HEAD isteginde If-None-Match kaynagin ETag'i ile eslesiyorsa true (weak karsilastirma).
+func notModified(ctx *gin.Context, etag string) bool {
+	if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead {
+		return false
+	}
+
+	header := ctx.GetHeader("If-None-Match")
+	return header != "" && matchETag(header, etag, true, false)
+}
+
+// matchETag: header'daki ETag listesinden (veya "*") biri etag ile eslesiyor mu?
+// anyRepresentation ise aday "3-json-en" gibi bir gosterim ETag'i olabilir, sadece version kismi karsilastirilir.
+func matchETag(header, etag string, weak, anyRepresentation bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+
+		if strings.HasPrefix(candidate, "W/") {
+			if !weak {
+				continue
+			}
+			candidate = candidate[2:]
+		}
+		if anyRepresentation {
+			if version, _, found := strings.Cut(candidate, "-"); found {
+				candidate = version + `"`
+			}
+		}
+		if candidate == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
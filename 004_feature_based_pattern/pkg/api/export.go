@@ -0,0 +1,69 @@
+package api
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"time"
+
+	"feature-base-starter-kit/pkg/tabular"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFormats: Accept header'inda desteklenen Content-Type'lar. Accept yoksa veya */* ise ilki (CSV) secilir.
+var exportFormats = map[string]tabular.Format{
+	tabular.ContentTypeCSV:    tabular.CSV,
+	tabular.ContentTypeNDJSON: tabular.NDJSON,
+	"application/ndjson":      tabular.NDJSON,
+	tabular.ContentTypeXLSX:   tabular.XLSX,
+}
+
+var exportOffers = []string{tabular.ContentTypeCSV, tabular.ContentTypeNDJSON, "application/ndjson", tabular.ContentTypeXLSX}
+
+// RowFunc: Export'a verilen fonksiyonun her kayit icin cagirdigi fonksiyon, degerler columns sirasinda olmalidir.
+type RowFunc func(values []any) error
+
+// Export: formati Accept header'ina gore secer (desteklenmiyorsa 406), dosyayi "<name>-<zaman>.<format>" adiyla
+// indirilecek sekilde (Content-Disposition: attachment) gonderir ve each'in urettigi satirlari yazar.
+// each satirlari veritabani cursor'undan okuyup hemen yazmalidir, tum kayitlar bellege alinmaz.
+//
+// Govdenin yazilmasi basladiktan sonra olusan hatalarda status degistirilemez; hata loglanir ve baglanti
+// yarim govdeyle kapanir (istemci eksik dosyayi Content-Length olmamasi / bozuk XLSX ile anlar).
+func Export(ctx *gin.Context, name string, columns []string, each func(write RowFunc) error) {
+	format, ok := exportFormats[ctx.NegotiateFormat(exportOffers...)]
+	if !ok {
+		SendError(ctx, http.StatusNotAcceptable, "request.not_acceptable", nil, "text/csv, application/x-ndjson, "+tabular.ContentTypeXLSX)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102-150405"), format)
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+
+	w, err := tabular.NewWriter(format, ctx.Writer)
+	if err == nil {
+		err = w.WriteHeader(columns)
+	}
+	if err == nil {
+		err = each(RowFunc(w.WriteRow))
+		if cerr := w.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err == nil {
+		return
+	}
+
+	if !ctx.Writer.Written() {
+		ctx.Writer.Header().Del("Content-Type")
+		ctx.Writer.Header().Del("Content-Disposition")
+		SendError(ctx, http.StatusInternalServerError, "server.internal_error", nil)
+		return
+	}
+	log.Printf("Export %s: %v", name, err)
+	ctx.Abort()
+}
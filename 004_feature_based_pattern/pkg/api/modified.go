@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotModifiedSince: Last-Modified header'ini ekler; GET/HEAD isteginde If-Modified-Since, lastModified'dan eski
+// degilse govdesiz 304 gonderir ve true doner. If-None-Match varsa If-Modified-Since yok sayilir (RFC 9110 13.1.3).
+// lastModified sifir ise (orn: hic kayit yok) header eklenmez.
+func NotModifiedSince(ctx *gin.Context, lastModified time.Time) bool {
+	if lastModified.IsZero() {
+		return false
+	}
+
+	// HTTP tarihleri saniye hassasiyetindedir
+	lastModified = lastModified.UTC().Truncate(time.Second)
+	ctx.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+
+	if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead || ctx.GetHeader("If-None-Match") != "" {
+		return false
+	}
+
+	since, err := http.ParseTime(ctx.GetHeader("If-Modified-Since"))
+	if err != nil || lastModified.After(since) {
+		return false
+	}
+
+	ctx.Status(http.StatusNotModified)
+	return true
+}
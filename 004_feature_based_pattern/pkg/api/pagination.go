@@ -0,0 +1,52 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// Pagination: ?page= ve ?per_page= query parametreleri. Gecersiz degerler varsayilana doner.
+type Pagination struct {
+	Page    int
+	PerPage int
+}
+
+func (p Pagination) Limit() int  { return p.PerPage }
+func (p Pagination) Offset() int { return (p.Page - 1) * p.PerPage }
+
+// PaginationFrom: istekteki sayfalama parametrelerini okur.
+func PaginationFrom(ctx *gin.Context) Pagination {
+	page, err := strconv.Atoi(ctx.Query("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	perPage, err := strconv.Atoi(ctx.Query("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	return Pagination{Page: page, PerPage: min(perPage, maxPerPage)}
+}
+
+// Page: listeleme cevaplarinda Data alani olarak gonderilir.
+type Page[T any] struct {
+	Items   []T `json:"items" xml:"items>item" yaml:"items"`
+	Page    int `json:"page" xml:"page" yaml:"page"`
+	PerPage int `json:"per_page" xml:"per_page" yaml:"per_page"`
+	Total   int `json:"total" xml:"total" yaml:"total"`
+}
+
+// NewPage: items nil ise bos liste olarak gonderilir.
+func NewPage[T any](items []T, p Pagination, total int) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, Page: p.Page, PerPage: p.PerPage, Total: total}
+}
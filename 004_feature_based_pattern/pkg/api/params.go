@@ -0,0 +1,22 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ParamID: path'teki pozitif tam sayi id parametresini okur (orn: /users/:id). Gecersizse ok=false doner.
+func ParamID(ctx *gin.Context, name string) (int64, bool) {
+	id, err := strconv.ParseInt(ctx.Param(name), 10, 64)
+	if err != nil || id < 1 {
+		return 0, false
+	}
+	return id, true
+}
+
+// QueryBool: ?name=true|1 gibi bool query parametresini okur. Yoksa veya gecersizse false doner.
+func QueryBool(ctx *gin.Context, name string) bool {
+	b, _ := strconv.ParseBool(ctx.Query(name))
+	return b
+}
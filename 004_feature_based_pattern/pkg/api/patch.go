@@ -0,0 +1,82 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// MergePatchContentType: RFC 7396, govde kaydin degisecek alanlarini icerir (null: alani siler).
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType: RFC 6902, govde islem listesidir (add, remove, replace, move, copy, test).
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+// BindPatch: PATCH isteginin govdesini current'a (kaydin guncel hali, genellikle PUT DTO'su) uygular,
+// sonucu obj'ye yazar ve binding kurallarini calistirir. obj bos bir DTO olmalidir; patch ile silinen alanlar sifir degerde kalir.
+// Hata varsa cevabi (400, 409, 415 veya 422) gonderir ve false doner.
+func BindPatch(ctx *gin.Context, current, obj any) bool {
+	doc, err := json.Marshal(current)
+	if err != nil {
+		SendError(ctx, http.StatusInternalServerError, "server.internal_error", nil)
+		return false
+	}
+
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		SendError(ctx, http.StatusBadRequest, "request.invalid_payload", nil)
+		return false
+	}
+
+	var patched []byte
+	switch ctx.ContentType() {
+	case MergePatchContentType:
+		if patched, err = jsonpatch.MergePatch(doc, body); err != nil {
+			SendError(ctx, http.StatusBadRequest, "request.invalid_patch", nil)
+			return false
+		}
+	case JSONPatchContentType:
+		ops, err := jsonpatch.DecodePatch(body)
+		if err != nil {
+			SendError(ctx, http.StatusBadRequest, "request.invalid_patch", nil)
+			return false
+		}
+		// Patch sozdizimi dogru fakat kayda uygulanamiyor (olmayan path, basarisiz test islemi): RFC 5789 2.2, 409 Conflict
+		if patched, err = ops.Apply(doc); err != nil {
+			SendError(ctx, http.StatusConflict, "request.patch_conflict", nil)
+			return false
+		}
+	default:
+		ctx.Header("Accept-Patch", MergePatchContentType+", "+JSONPatchContentType)
+		SendError(ctx, http.StatusUnsupportedMediaType, "request.unsupported_media_type", nil)
+		return false
+	}
+
+	// DTO'da olmayan alanlar (orn: id, version) patch ile degistirilemez
+	dec := json.NewDecoder(bytes.NewReader(patched))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(obj); err != nil {
+		SendError(ctx, http.StatusBadRequest, "request.invalid_patch", nil)
+		return false
+	}
+
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		var ve validator.ValidationErrors
+		if errors.As(err, &ve) {
+			SendValidationError(ctx, ve)
+			return false
+		}
+
+		SendError(ctx, http.StatusBadRequest, "request.invalid_payload", nil)
+		return false
+	}
+	return true
+}
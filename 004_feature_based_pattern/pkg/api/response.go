@@ -1,6 +1,13 @@
 package api
 
-import "github.com/gin-gonic/gin"
+import (
+	"feature-base-starter-kit/pkg/i18n"
+	"feature-base-starter-kit/pkg/validation"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
 
 type APIErrorResponse struct {
 	Message string              `json:"message" xml:"message" yaml:"message"`                            // struct tag
@@ -21,16 +28,46 @@ type APISuccessResponse struct {
 	Data    interface{} `json:"data,omitempty" xml:"data,omitempty" yaml:"data,omitempty"`
 }
 
-func SendError(ctx *gin.Context, status int, message string, errs map[string][]string) {
+// SendError: key, istegin dilinde cevrilerek (bkz. i18n) mesaj olarak gonderilir. args: mesajdaki {0}, {1}... parametreleri
+func SendError(ctx *gin.Context, status int, key string, errs map[string][]string, args ...any) {
 	ctx.JSON(status, APIErrorResponse{
-		Message: message,
+		Message: i18n.FromContext(ctx).T(key, args...),
 		Errors:  errs,
 	})
 }
 
-func SendSuccess(ctx *gin.Context, status int, message string, data interface{}) {
-	ctx.JSON(status, APISuccessResponse{
-		Message: message,
+// SendValidationError: validation hatalarini istegin dilinde 422 olarak gonderir.
+func SendValidationError(ctx *gin.Context, ve validator.ValidationErrors) {
+	loc := i18n.FromContext(ctx)
+	SendError(ctx, http.StatusUnprocessableEntity, "validation.failed", validation.MapValidationErrorsWith(ve, loc.Translator()))
+}
+
+// SendSuccess: key, istegin dilinde cevrilerek mesaj olarak gonderilir.
+// ?format=xml veya ?format=yaml ile cikti formati secilebilir, varsayilan JSON.
+// data Versioned ise format ve dile ozel ETag header'i eklenir; GET isteginde If-None-Match eslesirse govdesiz 304 doner.
+func SendSuccess(ctx *gin.Context, status int, key string, data interface{}, args ...any) {
+	if v, ok := data.(Versioned); ok {
+		etag := representationETag(ctx, v.ETag())
+		ctx.Header("ETag", etag)
+		ctx.Header("Vary", "Accept, Accept-Language")
+
+		if notModified(ctx, etag) {
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	response := APISuccessResponse{
+		Message: i18n.FromContext(ctx).T(key, args...),
 		Data:    data,
-	})
+	}
+
+	switch ctx.Query("format") {
+	case "xml":
+		ctx.XML(status, response)
+	case "yaml", "yml":
+		ctx.YAML(status, response)
+	default:
+		ctx.JSON(status, response)
+	}
 }
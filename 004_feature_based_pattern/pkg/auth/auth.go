@@ -0,0 +1,38 @@
+// Package auth: istegi yapan API anahtarinin rolu. Roller hiyerarsiktir: admin > editor > author.
+package auth
+
+import "github.com/gin-gonic/gin"
+
+type Role string
+
+const (
+	RoleAuthor Role = "author" // makale yazar, incelemeye gonderir
+	RoleEditor Role = "editor" // yayinlar, zamanlar, arsivler
+	RoleAdmin  Role = "admin"  // API_SECRET_KEY, tum yetkiler
+	// RoleSystem: istek disi islemler (orn: zamanlanmis makaleleri yayinlayan scheduler). Kayitlarda (history) kullanilir.
+	RoleSystem Role = "system"
+)
+
+var levels = map[Role]int{RoleAuthor: 1, RoleEditor: 2, RoleAdmin: 3, RoleSystem: 3}
+
+// Allows: r en az min kadar yetkili mi?
+func (r Role) Allows(min Role) bool {
+	return levels[r] >= levels[min]
+}
+
+const contextKey = "auth.role"
+
+// Set: middleware.AuthMiddleware tarafindan, anahtar dogrulandiktan sonra cagrilir.
+func Set(ctx *gin.Context, r Role) {
+	ctx.Set(contextKey, r)
+}
+
+// FromContext: istegin rolunu dondurur, kimlik dogrulamasi yapilmadiysa bos string (hicbir yetkisi yoktur).
+func FromContext(ctx *gin.Context) Role {
+	if v, ok := ctx.Get(contextKey); ok {
+		if r, ok := v.(Role); ok {
+			return r
+		}
+	}
+	return ""
+}
@@ -0,0 +1,345 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib" // "pgx" driver'ini database/sql'e kaydeder
+	_ "modernc.org/sqlite"             // "sqlite" driver'ini database/sql'e kaydeder
+)
+
+// Config: baglanti ve connection pool ayarlari.
+// DSN verilirse Host/Port/User/Password/Name alanlari kullanilmaz.
+type Config struct {
+	Driver   string // "postgres", "mysql" veya "sqlite"
+	DSN      string
+	Host     string
+	Port     int
+	User     string
+	Password string
+	Name     string // sqlite icin dosya yolu (orn: dev.db) veya ":memory:"
+	SSLMode  string // sadece postgres, orn: disable, require
+
+	MaxOpenConns     int
+	MaxIdleConns     int
+	ConnMaxLifetime  time.Duration
+	ConnMaxIdleTime  time.Duration
+	StatementTimeout time.Duration // 0 ise sinir yok
+
+	ConnectRetries int           // ilk baglantida kac kez denenecek
+	RetryBackoff   time.Duration // ilk bekleme suresi, her denemede 2 katina cikar
+}
+
+// DB: *sql.DB'yi, kullanilan driver'i ve dialect'i tasir.
+// ExecContext, QueryContext ve QueryRowContext sorgudaki "?" placeholder'larini dialect'e gore cevirir,
+// bu yuzden repository'ler sorgularini tek bir formatta yazar. Transaction icin bkz. TxManager.
+type DB struct {
+	*sql.DB
+	Driver  string
+	Dialect Dialect
+}
+
+var (
+	// ErrNotFound: repository'lerin kayit bulunamadiginda dondurdugu hata.
+	ErrNotFound = errors.New("database: record not found")
+	// ErrVersionMismatch: kosullu guncellemede (optimistic locking) kayit bu arada baska biri tarafindan degistirilmis.
+	ErrVersionMismatch = errors.New("database: record was modified concurrently")
+)
+
+const maxBackoff = 30 * time.Second
+
+// Open: pool'u acar ve veritabani hazir olana kadar (veya deneme sayisi bitene kadar) artan araliklarla ping atar.
+func Open(ctx context.Context, cfg Config) (*DB, error) {
+	dialect, err := DialectFor(cfg.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	driverName, dsn, err := dataSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Driver == "sqlite" && isMemory(cfg) {
+		// bellekteki veritabani baglantiya ozeldir: tek baglanti acik tutulur ve hic kapatilmaz
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+		sqlDB.SetConnMaxIdleTime(0)
+	} else {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	attempts := max(cfg.ConnectRetries, 1)
+	backoff := cfg.RetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		err = sqlDB.PingContext(pingCtx)
+		cancel()
+
+		if err == nil {
+			break
+		}
+
+		if attempt >= attempts {
+			sqlDB.Close()
+			return nil, fmt.Errorf("database: could not connect after %d attempts: %w", attempt, err)
+		}
+
+		log.Printf("Database is not ready (attempt %d/%d): %v, retrying in %s", attempt, attempts, err, backoff)
+
+		select {
+		case <-ctx.Done():
+			sqlDB.Close()
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+
+	return &DB{DB: sqlDB, Driver: cfg.Driver, Dialect: dialect}, nil
+}
+
+// ExecContext, QueryContext, QueryRowContext: ctx'te TxManager.WithinTx ile acilmis bir transaction varsa sorgu onun icinde calisir.
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return db.conn(ctx).ExecContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return db.conn(ctx).QueryContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return db.conn(ctx).QueryRowContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+// Insert: INSERT sorgusunu calistirir ve olusan kaydin id'sini dondurur.
+// RETURNING destekleyen dialect'lerde sorguya "RETURNING id" eklenir, digerlerinde LastInsertId kullanilir.
+func (db *DB) Insert(ctx context.Context, query string, args ...any) (int64, error) {
+	var id int64
+
+	if db.Dialect.SupportsReturning() {
+		err := db.QueryRowContext(ctx, query+" RETURNING id", args...).Scan(&id)
+		return id, err
+	}
+
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+// WithVersion: version > 0 ise UPDATE sorgusuna "AND version = ?" kosulunu ekler (optimistic locking).
+// Sorgu version'i arttirmalidir (SET version = version + 1); boylece satir her zaman degisir ve
+// RowsAffected MySQL'de de guvenilir olur: 0 ise kayit yoktur veya version degismistir.
+func WithVersion(query string, version int64, args ...any) (string, []any) {
+	if version > 0 {
+		return query + " AND version = ?", append(args, version)
+	}
+	return query, args
+}
+
+// SetClause: kolon -> deger map'inden UPDATE icin "a = ?, b = ?" parcasini ve argumanlari uretir (kismi guncelleme, PATCH).
+// Kolonlar alfabetik siralanir, boylece ayni degisiklikler her zaman ayni sorguyu uretir.
+func (db *DB) SetClause(changes map[string]any) (string, []any) {
+	columns := make([]string, 0, len(changes))
+	for col := range changes {
+		columns = append(columns, col)
+	}
+	sort.Strings(columns)
+
+	sets := make([]string, len(columns))
+	args := make([]any, len(columns))
+	for i, col := range columns {
+		sets[i] = db.Dialect.Quote(col) + " = ?"
+		args[i] = changes[col]
+	}
+	return strings.Join(sets, ", "), args
+}
+
+// Exists: validation.RecordChecker'i uygular (unique ve exists kurallari).
+// table ve column struct tag'lerinden gelir, yine de sorguya eklenmeden once dogrulanir.
+func (db *DB) Exists(ctx context.Context, table, column string, value any) (bool, error) {
+	if !isIdentifier(table) || !isIdentifier(column) {
+		return false, fmt.Errorf("database: invalid identifier %s.%s", table, column)
+	}
+
+	q := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE %s = ?)", db.Dialect.Quote(table), db.Dialect.Quote(column))
+
+	var found bool
+	err := db.QueryRowContext(ctx, q, value).Scan(&found)
+	return found, err
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i > 0 && r >= '0' && r <= '9') {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// dataSource: database/sql driver adini ve DSN'i dondurur.
+func dataSource(cfg Config) (string, string, error) {
+	switch cfg.Driver {
+	case "postgres":
+		if cfg.DSN != "" {
+			return "pgx", cfg.DSN, nil
+		}
+		return "pgx", postgresDSN(cfg), nil
+	case "mysql":
+		if cfg.DSN != "" {
+			return "mysql", cfg.DSN, nil
+		}
+		return "mysql", mysqlDSN(cfg), nil
+	case "sqlite":
+		return "sqlite", sqliteDSN(cfg), nil
+	}
+
+	return "", "", fmt.Errorf("database: unsupported driver %q", cfg.Driver)
+}
+
+func postgresDSN(cfg Config) string {
+	port := cfg.Port
+	if port == 0 {
+		port = 5432
+	}
+
+	q := url.Values{}
+	if cfg.SSLMode != "" {
+		q.Set("sslmode", cfg.SSLMode)
+	}
+	if cfg.StatementTimeout > 0 {
+		// pgx bilinmeyen parametreleri oturum ayari (runtime param) olarak gonderir
+		q.Set("statement_timeout", strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10))
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		Path:     "/" + cfg.Name,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+func mysqlDSN(cfg Config) string {
+	port := cfg.Port
+	if port == 0 {
+		port = 3306
+	}
+
+	mc := mysql.NewConfig()
+	mc.User = cfg.User
+	mc.Passwd = cfg.Password
+	mc.Net = "tcp"
+	mc.Addr = net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+	mc.DBName = cfg.Name
+	mc.ParseTime = true
+	if cfg.StatementTimeout > 0 {
+		// max_execution_time sadece SELECT sorgularini sinirlar (ms)
+		mc.Params = map[string]string{
+			"max_execution_time": strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10),
+		}
+	}
+
+	return mc.FormatDSN()
+}
+
+// sqliteDSN: foreign key kontrolu SQLite'ta baglanti basina acilir, bu yuzden DSN'e pragma olarak eklenir.
+// DSN verilmisse sadece eksik pragma'lar eklenir.
+func sqliteDSN(cfg Config) string {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = "file:" + cfg.Name
+	}
+
+	var pragmas []string
+	if !strings.Contains(dsn, "foreign_keys") {
+		pragmas = append(pragmas, "_pragma=foreign_keys(1)")
+	}
+	if !strings.Contains(dsn, "busy_timeout") {
+		pragmas = append(pragmas, "_pragma=busy_timeout(5000)")
+	}
+	// Go'dan yazilan zamanlar (deleted_at, published_at) "2006-01-02 15:04:05.999999999-07:00" formatinda saklanir,
+	// varsayilan format (time.String) metin olarak karsilastirildiginda (published_at <= ?) dogru siralanmaz
+	if !strings.Contains(dsn, "_time_format") {
+		pragmas = append(pragmas, "_time_format=sqlite")
+	}
+	if len(pragmas) == 0 {
+		return dsn
+	}
+
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return dsn + sep + strings.Join(pragmas, "&")
+}
+
+func isMemory(cfg Config) bool {
+	return cfg.Name == ":memory:" || strings.Contains(cfg.DSN, ":memory:") || strings.Contains(cfg.DSN, "mode=memory")
+}
+
+// PoolStats: connection pool istatistikleri (JSON olarak disari acilabilir).
+type PoolStats struct {
+	MaxOpenConnections int    `json:"max_open_connections"`
+	OpenConnections    int    `json:"open_connections"`
+	InUse              int    `json:"in_use"`
+	Idle               int    `json:"idle"`
+	WaitCount          int64  `json:"wait_count"`
+	WaitDuration       string `json:"wait_duration"`
+	MaxIdleClosed      int64  `json:"max_idle_closed"`
+	MaxIdleTimeClosed  int64  `json:"max_idle_time_closed"`
+	MaxLifetimeClosed  int64  `json:"max_lifetime_closed"`
+}
+
+func (db *DB) PoolStats() PoolStats {
+	s := db.Stats()
+	return PoolStats{
+		MaxOpenConnections: s.MaxOpenConnections,
+		OpenConnections:    s.OpenConnections,
+		InUse:              s.InUse,
+		Idle:               s.Idle,
+		WaitCount:          s.WaitCount,
+		WaitDuration:       s.WaitDuration.String(),
+		MaxIdleClosed:      s.MaxIdleClosed,
+		MaxIdleTimeClosed:  s.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  s.MaxLifetimeClosed,
+	}
+}
+
+// ReadinessCheck: health.RegisterReadiness ile kullanilir. Ping basariliysa pool istatistiklerini dondurur.
+func (db *DB) ReadinessCheck(ctx context.Context) (any, error) {
+	if err := db.PingContext(ctx); err != nil {
+		return nil, err
+	}
+	return db.PoolStats(), nil
+}
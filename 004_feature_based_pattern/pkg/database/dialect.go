@@ -0,0 +1,221 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Dialect: veritabanina gore degisen SQL parcalarini uretir. Repository'ler sorgulari "?" placeholder'i ile yazar,
+// DB.ExecContext/QueryContext/QueryRowContext sorguyu dialect'e gore cevirir (Rebind).
+type Dialect interface {
+	Name() string
+	// Rebind: "?" placeholder'larini dialect'in formatina cevirir (postgres: $1, $2, ...).
+	Rebind(query string) string
+	// Quote: tablo/kolon adini tirnak icine alir.
+	Quote(ident string) string
+	// Upsert: cakisma (conflict) kolonlarinda kayit varsa update kolonlarini gunceller, update bos ise hicbir sey yapmaz.
+	Upsert(table string, columns, conflict, update []string) string
+	// SupportsReturning: INSERT ... RETURNING destekleniyor mu? Desteklenmiyorsa LastInsertId kullanilir.
+	SupportsReturning() bool
+	// MultiStatement: tek bir Exec ile birden fazla statement calistirilabiliyor mu? (migration'lar icin)
+	MultiStatement() bool
+	IsUniqueViolation(err error) bool
+	IsForeignKeyViolation(err error) bool
+	// IsRetryable: transaction tekrar denendiginde basarili olabilecek hatalar (serialization failure, deadlock, kilit).
+	IsRetryable(err error) bool
+}
+
+var dialects = map[string]Dialect{
+	"postgres": postgresDialect{},
+	"mysql":    mysqlDialect{},
+	"sqlite":   sqliteDialect{},
+}
+
+// DialectFor: driver adina gore dialect dondurur.
+func DialectFor(driver string) (Dialect, error) {
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("database: unsupported driver %q", driver)
+	}
+	return d, nil
+}
+
+// Placeholders: n adet "?" placeholder'i uretir (orn: IN (?, ?, ?) veya VALUES listeleri icin).
+func Placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func quoteAll(d Dialect, idents []string) string {
+	quoted := make([]string, len(idents))
+	for i, ident := range idents {
+		quoted[i] = d.Quote(ident)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// PostgreSQL
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string { return "postgres" }
+
+func (postgresDialect) Rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	n, inString := 0, false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if c == '\'' {
+			inString = !inString
+		}
+		if c == '?' && !inString {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String()
+}
+
+func (postgresDialect) Quote(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+func (d postgresDialect) Upsert(table string, columns, conflict, update []string) string {
+	q := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON CONFLICT (%s)",
+		d.Quote(table), quoteAll(d, columns), Placeholders(len(columns)), quoteAll(d, conflict))
+
+	if len(update) == 0 {
+		return q + " DO NOTHING"
+	}
+
+	sets := make([]string, len(update))
+	for i, col := range update {
+		sets[i] = fmt.Sprintf("%s = EXCLUDED.%s", d.Quote(col), d.Quote(col))
+	}
+	return q + " DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+func (postgresDialect) SupportsReturning() bool { return true }
+
+func (postgresDialect) MultiStatement() bool { return true }
+
+func (postgresDialect) IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func (postgresDialect) IsForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// IsRetryable: 40001 serialization_failure, 40P01 deadlock_detected.
+func (postgresDialect) IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && (pgErr.Code == "40001" || pgErr.Code == "40P01")
+}
+
+// MySQL
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) Name() string { return "mysql" }
+
+func (mysqlDialect) Rebind(query string) string { return query }
+
+func (mysqlDialect) Quote(ident string) string {
+	return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+}
+
+func (d mysqlDialect) Upsert(table string, columns, conflict, update []string) string {
+	q := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) ON DUPLICATE KEY UPDATE ",
+		d.Quote(table), quoteAll(d, columns), Placeholders(len(columns)))
+
+	if len(update) == 0 {
+		// INSERT IGNORE diger hatalari da (FK vb.) yutacagi icin, cakismada kolonu kendisine esitliyoruz
+		return q + fmt.Sprintf("%s = %s", d.Quote(conflict[0]), d.Quote(conflict[0]))
+	}
+
+	sets := make([]string, len(update))
+	for i, col := range update {
+		sets[i] = fmt.Sprintf("%s = VALUES(%s)", d.Quote(col), d.Quote(col))
+	}
+	return q + strings.Join(sets, ", ")
+}
+
+func (mysqlDialect) SupportsReturning() bool { return false }
+
+func (mysqlDialect) MultiStatement() bool { return false }
+
+func (mysqlDialect) IsUniqueViolation(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && myErr.Number == 1062
+}
+
+func (mysqlDialect) IsForeignKeyViolation(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && (myErr.Number == 1452 || myErr.Number == 1451)
+}
+
+// IsRetryable: 1213 deadlock, 1205 lock wait timeout.
+func (mysqlDialect) IsRetryable(err error) bool {
+	var myErr *mysql.MySQLError
+	return errors.As(err, &myErr) && (myErr.Number == 1213 || myErr.Number == 1205)
+}
+
+// SQLite (modernc.org/sqlite, cgo gerektirmez). Yerel gelistirme ve container'siz calistirma icin.
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string { return "sqlite" }
+
+func (sqliteDialect) Rebind(query string) string { return query }
+
+func (sqliteDialect) Quote(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+// Upsert: SQLite 3.24+ postgres ile ayni ON CONFLICT sozdizimini destekler.
+func (sqliteDialect) Upsert(table string, columns, conflict, update []string) string {
+	return postgresDialect{}.Upsert(table, columns, conflict, update)
+}
+
+func (sqliteDialect) SupportsReturning() bool { return true }
+
+func (sqliteDialect) MultiStatement() bool { return true }
+
+func (sqliteDialect) IsUniqueViolation(err error) bool {
+	var sqErr *sqlite.Error
+	return errors.As(err, &sqErr) && (sqErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || sqErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY)
+}
+
+func (sqliteDialect) IsForeignKeyViolation(err error) bool {
+	var sqErr *sqlite.Error
+	return errors.As(err, &sqErr) && sqErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+}
+
+// IsRetryable: SQLITE_BUSY ve SQLITE_LOCKED (genisletilmis kodlar dahil, orn: SQLITE_BUSY_SNAPSHOT).
+func (sqliteDialect) IsRetryable(err error) bool {
+	var sqErr *sqlite.Error
+	if !errors.As(err, &sqErr) {
+		return false
+	}
+	code := sqErr.Code() & 0xff
+	return code == sqlite3.SQLITE_BUSY || code == sqlite3.SQLITE_LOCKED
+}
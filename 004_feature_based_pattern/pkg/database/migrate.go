@@ -0,0 +1,130 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Migrate: fsys icindeki <dialect>/NNN_name.up.sql dosyalarini sirayla calistirir.
+// Calisan migration'lar schema_migrations tablosuna yazilir, tekrar calistirilmaz.
+// Her migration kendi transaction'i icinde calisir (MySQL'de DDL transaction'a dahil olmaz, orada yarim kalan migration elle duzeltilmelidir).
+func Migrate(ctx context.Context, db *DB, fsys fs.FS) error {
+	dir := db.Dialect.Name()
+
+	files, err := fs.Glob(fsys, dir+"/*.up.sql")
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("database: no migrations found for %s", dir)
+	}
+	sort.Strings(files)
+
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version VARCHAR(255) PRIMARY KEY,
+		applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	)`); err != nil {
+		return fmt.Errorf("database: creating schema_migrations: %w", err)
+	}
+
+	applied, err := appliedMigrations(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		version := strings.TrimSuffix(path.Base(file), ".up.sql")
+		if applied[version] {
+			continue
+		}
+
+		body, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return err
+		}
+
+		if err := applyMigration(ctx, db, version, string(body)); err != nil {
+			return fmt.Errorf("database: migration %s: %w", version, err)
+		}
+		log.Printf("Applied migration %s", version)
+	}
+
+	return nil
+}
+
+func appliedMigrations(ctx context.Context, db *DB) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[string]bool{}
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		applied[v] = true
+	}
+	return applied, rows.Err()
+}
+
+func applyMigration(ctx context.Context, db *DB, version, body string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	statements := []string{body}
+	if !db.Dialect.MultiStatement() {
+		statements = splitStatements(body)
+	}
+
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, db.Dialect.Rebind("INSERT INTO schema_migrations (version) VALUES (?)"), version); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// splitStatements: migration dosyasini satir sonundaki ";" karakterlerinden boler.
+// Sadece "--" yorumlari desteklenir; statement icinde satir sonunda ";" olmamalidir.
+func splitStatements(body string) []string {
+	var (
+		out []string
+		cur strings.Builder
+	)
+
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		cur.WriteString(line)
+		cur.WriteByte('\n')
+
+		if strings.HasSuffix(trimmed, ";") {
+			out = append(out, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(cur.String()); rest != "" {
+		out = append(out, rest)
+	}
+	return out
+}
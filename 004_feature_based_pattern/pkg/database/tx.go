@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// querier: *sql.DB ve *sql.Tx'in ortak metotlari.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type txKey struct{}
+
+// txState: ctx'te tasinan aktif transaction. depth, ic ice WithinTx cagrilarinda savepoint adi icin kullanilir.
+type txState struct {
+	db    *DB
+	tx    *sql.Tx
+	depth int
+}
+
+// conn: ctx'te bu DB'ye ait bir transaction varsa onu, yoksa pool'u dondurur.
+func (db *DB) conn(ctx context.Context) querier {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok && st.db == db {
+		return st.tx
+	}
+	return db.DB
+}
+
+// TxManager: handler'larda birden fazla repository islemini tek bir transaction'da (unit of work) calistirir.
+//
+//	err := h.tx.WithinTx(ctx, func(ctx context.Context) error {
+//		if err := h.articles.Create(ctx, a); err != nil {
+//			return err
+//		}
+//		return h.tags.Attach(ctx, a.ID, names)
+//	})
+//
+// Repository'ler transaction'i ctx'ten alir (bkz. DB.ExecContext), bu yuzden fn icinde mutlaka verilen ctx kullanilmalidir.
+// *sql.Tx eszamanli kullanima uygun olmadigi icin fn icinde ayni ctx ile goroutine baslatilmamalidir.
+type TxManager struct {
+	db *DB
+
+	// MaxRetries: serialization failure / deadlock durumunda en fazla kac kez tekrar denenecek.
+	MaxRetries int
+	// RetryBackoff: ilk tekrar oncesi bekleme suresi, her denemede 2 katina cikar.
+	RetryBackoff time.Duration
+	// Options: transaction izolasyon seviyesi vb. nil ise veritabaninin varsayilani kullanilir.
+	Options *sql.TxOptions
+}
+
+func NewTxManager(db *DB) *TxManager {
+	return &TxManager{db: db, MaxRetries: 3, RetryBackoff: 20 * time.Millisecond}
+}
+
+// WithinTx: fn'i bir transaction icinde calistirir. fn hata dondururse veya panic olursa rollback yapilir
+// (panic rollback'ten sonra tekrar firlatilir), aksi halde commit edilir.
+//
+// ctx'te zaten bir transaction varsa yeni transaction acilmaz, fn bir SAVEPOINT icinde calisir:
+// fn'in hatasi sadece kendi yaptigi degisiklikleri geri alir, dis transaction devam edebilir.
+//
+// Dialect'in IsRetryable dedigi hatalarda (serialization failure, deadlock) transaction bastan tekrar denenir,
+// bu yuzden fn yan etkisiz (sadece veritabani islemi yapan) olmalidir. Ic ice cagrilar tekrar denenmez, hata dis transaction'a iletilir.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if st, ok := ctx.Value(txKey{}).(*txState); ok && st.db == m.db {
+		return m.withinSavepoint(ctx, st, fn)
+	}
+
+	backoff := m.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := m.run(ctx, fn)
+		if err == nil || attempt >= m.MaxRetries || !m.db.Dialect.IsRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (m *TxManager) run(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	tx, err := m.db.BeginTx(ctx, m.Options)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{db: m.db, tx: tx})); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *TxManager) withinSavepoint(ctx context.Context, parent *txState, fn func(ctx context.Context) error) (err error) {
+	st := &txState{db: m.db, tx: parent.tx, depth: parent.depth + 1}
+	name := fmt.Sprintf("sp_%d", st.depth)
+
+	if _, err := st.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_, _ = st.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, st)); err != nil {
+		if _, rbErr := st.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	_, err = st.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Check: bir bagimliligin (veritabani vb.) hazir olup olmadigini kontrol eder.
+// Donen details, readiness cevabinda gosterilir (orn: pool istatistikleri).
+type Check func(ctx context.Context) (details any, err error)
+
+var (
+	mu     sync.RWMutex
+	checks = map[string]Check{}
+)
+
+const checkTimeout = 3 * time.Second
+
+// RegisterReadiness: /health/ready endpoint'inde calistirilacak bir kontrol ekler.
+func RegisterReadiness(name string, check Check) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	checks[name] = check
+}
+
+type checkResult struct {
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+// LiveHandler: uygulama ayakta mi? Bagimliliklari kontrol etmez.
+func LiveHandler(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// ReadyHandler: kayitli tum kontrolleri calistirir. Biri bile basarisizsa 503 doner.
+func ReadyHandler(ctx *gin.Context) {
+	mu.RLock()
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	mu.RUnlock()
+	sort.Strings(names)
+
+	status := http.StatusOK
+	results := make(map[string]checkResult, len(names))
+
+	for _, name := range names {
+		mu.RLock()
+		check := checks[name]
+		mu.RUnlock()
+
+		c, cancel := context.WithTimeout(ctx.Request.Context(), checkTimeout)
+		details, err := check(c)
+		cancel()
+
+		if err != nil {
+			status = http.StatusServiceUnavailable
+			results[name] = checkResult{Status: "down", Error: err.Error()}
+			continue
+		}
+		results[name] = checkResult{Status: "up", Details: details}
+	}
+
+	overall := "ok"
+	if status != http.StatusOK {
+		overall = "unavailable"
+	}
+
+	ctx.JSON(status, gin.H{"status": overall, "checks": results})
+}
@@ -0,0 +1,218 @@
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"feature-base-starter-kit/pkg/validation"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/goccy/go-yaml"
+)
+
+// Validation disindaki mesajlar (basari/hata mesajlari vb.) locales/<dil>.yaml dosyalarindaki "messages" bolumunden okunur
+// ve pkg/validation'in universal-translator instance'ina eklenir. Boylece tek bir translator her iki mesaj tipini de cevirir.
+//
+//	# locales/tr.yaml
+//	messages:
+//	  user.created: "Kullanıcı başarıyla oluşturuldu"
+//	  user.not_found: "{0} numaralı kullanıcı bulunamadı"
+//
+//go:embed locales/*.yaml
+var embeddedLocales embed.FS
+
+const fallbackLang = "en"
+
+// catalog: dil -> anahtar -> ham mesaj metni
+var catalog map[string]map[string]string
+
+type localeFile struct {
+	Messages map[string]string `yaml:"messages"`
+}
+
+// Init: mesajlari yukler ve validation translator'larina ekler. validation.Init'ten sonra cagrilmalidir.
+// dir bos degilse, dizindeki <dil>.yaml dosyalarinin "messages" bolumu gomulu mesajlarin uzerine yazilir.
+func Init(dir string) error {
+	cat, err := loadCatalog(dir)
+	if err != nil {
+		return err
+	}
+
+	for lang, msgs := range cat {
+		trans, ok := validation.Translator(lang)
+		if !ok {
+			continue
+		}
+
+		for key, text := range msgs {
+			if err := trans.Add(key, text, true); err != nil {
+				return fmt.Errorf("i18n: %s: %s: %w", lang, key, err)
+			}
+		}
+	}
+
+	catalog = cat
+	return nil
+}
+
+func loadCatalog(dir string) (map[string]map[string]string, error) {
+	out := make(map[string]map[string]string)
+
+	if err := readLocales(embeddedLocales, "locales", out); err != nil {
+		return out, err
+	}
+
+	if dir != "" {
+		if err := readLocales(os.DirFS(dir), ".", out); err != nil {
+			return out, err
+		}
+	}
+
+	return out, nil
+}
+
+func readLocales(fsys fs.FS, dir string, out map[string]map[string]string) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.yaml"))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range files {
+		lang := strings.TrimSuffix(path.Base(name), ".yaml")
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		var file localeFile
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if out[lang] == nil {
+			out[lang] = make(map[string]string)
+		}
+		for key, text := range file.Messages {
+			out[lang][key] = text
+		}
+	}
+
+	return nil
+}
+
+// CheckLocales: mesaj dosyalarinda, herhangi bir dilde olup digerlerinde eksik olan anahtarlari dondurur.
+func CheckLocales(dir string) (map[string][]string, error) {
+	cat, err := loadCatalog(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make(map[string]struct{})
+	for _, msgs := range cat {
+		for key := range msgs {
+			all[key] = struct{}{}
+		}
+	}
+
+	langs := validation.SupportedLanguages()
+	for lang := range cat {
+		if !slices.Contains(langs, lang) {
+			langs = append(langs, lang)
+		}
+	}
+
+	out := make(map[string][]string)
+	for _, lang := range langs {
+		var missing []string
+		for key := range all {
+			if _, ok := cat[lang][key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			out[lang] = missing
+		}
+	}
+
+	return out, nil
+}
+
+// Localizer: tek bir dile bagli ceviri yardimcisi. Her istek icin Middleware tarafindan olusturulur.
+type Localizer struct {
+	lang  string
+	trans ut.Translator
+}
+
+// New: verilen dil icin Localizer olusturur. Dil desteklenmiyorsa varsayilan dil kullanilir.
+func New(lang string) *Localizer {
+	trans, ok := validation.Translator(lang)
+	if !ok {
+		trans = validation.DefaultTranslator()
+	}
+
+	return &Localizer{lang: trans.Locale(), trans: trans}
+}
+
+// Default: varsayilan dil (APP_LANG) icin Localizer.
+func Default() *Localizer {
+	return New(validation.DefaultTranslator().Locale())
+}
+
+// Lang: Localizer'in dil kodu.
+func (l *Localizer) Lang() string {
+	return l.lang
+}
+
+// Translator: validation hatalarini ayni dilde cevirmek icin (bkz. validation.MapValidationErrorsWith).
+func (l *Localizer) Translator() ut.Translator {
+	return l.trans
+}
+
+var placeholderRegex = regexp.MustCompile(`\{(\d+)\}`)
+
+// T: anahtari cevirir, {0}, {1}... yerine args yazilir. Dilde anahtar yoksa Ingilizce, o da yoksa anahtarin kendisi doner.
+func (l *Localizer) T(key string, args ...any) string {
+	trans, text := l.trans, catalog[l.lang][key]
+	if text == "" {
+		if fb, ok := validation.Translator(fallbackLang); ok && catalog[fallbackLang][key] != "" {
+			trans, text = fb, catalog[fallbackLang][key]
+		} else {
+			return key
+		}
+	}
+
+	// ut.T eksik parametrede panic edecegi icin, parametreler mesajdaki placeholder sayisina tamamlanir
+	params := make([]string, placeholderCount(text))
+	for i := range params {
+		if i < len(args) {
+			params[i] = fmt.Sprint(args[i])
+		}
+	}
+
+	s, err := trans.T(key, params...)
+	if err != nil {
+		return key
+	}
+	return s
+}
+
+func placeholderCount(text string) int {
+	n := 0
+	for _, m := range placeholderRegex.FindAllStringSubmatch(text, -1) {
+		if i, err := strconv.Atoi(m[1]); err == nil && i+1 > n {
+			n = i + 1
+		}
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const contextKey = "i18n.localizer"
+
+// Middleware: istegin dilini belirler ve gin context'ine bir Localizer koyar.
+// Oncelik: ?lang= query parametresi, Accept-Language header'i, varsayilan dil (APP_LANG).
+func Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		loc := New(resolveLang(ctx))
+
+		ctx.Set(contextKey, loc)
+		ctx.Header("Content-Language", loc.Lang())
+
+		ctx.Next()
+	}
+}
+
+// FromContext: istegin Localizer'ini dondurur. Middleware kullanilmadiysa varsayilan dil kullanilir.
+func FromContext(ctx *gin.Context) *Localizer {
+	if v, ok := ctx.Get(contextKey); ok {
+		if loc, ok := v.(*Localizer); ok {
+			return loc
+		}
+	}
+	return Default()
+}
+
+func resolveLang(ctx *gin.Context) string {
+	if lang := strings.ToLower(strings.TrimSpace(ctx.Query("lang"))); lang != "" {
+		if _, ok := lookup(lang); ok {
+			return lang
+		}
+	}
+
+	for _, lang := range parseAcceptLanguage(ctx.GetHeader("Accept-Language")) {
+		if l, ok := lookup(lang); ok {
+			return l
+		}
+	}
+
+	return ""
+}
+
+// lookup: "tr-TR" gibi bolgesel kodlari ana dile ("tr") indirger ve desteklenip desteklenmedigini kontrol eder.
+func lookup(lang string) (string, bool) {
+	base, _, _ := strings.Cut(strings.ReplaceAll(lang, "_", "-"), "-")
+	if base == "" {
+		return "", false
+	}
+
+	loc := New(base)
+	return loc.Lang(), loc.Lang() == base
+}
+
+// parseAcceptLanguage: "tr-TR,tr;q=0.9,en;q=0.8" -> ["tr-tr", "tr", "en"] (q degerine gore sirali)
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+
+	var langs []weighted
+	for _, part := range strings.Split(header, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if lang == "" || lang == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+
+		langs = append(langs, weighted{lang: strings.ToLower(lang), q: q})
+	}
+
+	sort.SliceStable(langs, func(i, j int) bool { return langs[i].q > langs[j].q })
+
+	out := make([]string, 0, len(langs))
+	for _, l := range langs {
+		out = append(out, l.lang)
+	}
+	return out
+}
@@ -0,0 +1,137 @@
+// Package markdown: Markdown'i (CommonMark + tablolar, ustu cizili, otomatik linkler) HTML'e cevirir.
+// Basliklara slug'dan uretilen id'ler (anchor) eklenir, kod bloklari chroma ile CSS siniflariyla renklendirilir
+// (<pre class="chroma"><code class="language-go"><span class="kd">...), ayrica icindekiler (TOC) listesi dondurulur.
+// Ham HTML ciktiya aynen yazilir; cikti kullaniciya gosterilmeden once sanitize edilmelidir.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"strconv"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+
+	"feature-base-starter-kit/pkg/slug"
+)
+
+// Heading: icindekiler listesindeki bir baslik. ID, HTML'deki baslik elemaninin id'si (href="#ID").
+type Heading struct {
+	Level int    `json:"level" xml:"level" yaml:"level"`
+	ID    string `json:"id" xml:"id" yaml:"id"`
+	Text  string `json:"text" xml:"text" yaml:"text"`
+}
+
+// Tags ve Attributes: Render'in kendi urettigi etiket ve attribute'lar (sanitizer allow-list'ine eklenmelidir).
+var (
+	Tags       = []string{"del", "span"}
+	Attributes = []string{"h1:id", "h2:id", "h3:id", "h4:id", "h5:id", "h6:id", "pre:class", "code:class", "span:class", "th:align", "td:align"}
+)
+
+var md = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		highlighting.NewHighlighting(
+			highlighting.WithFormatOptions(chromahtml.WithClasses(true), chromahtml.PreventSurroundingPre(true)),
+			highlighting.WithWrapperRenderer(wrapCode),
+		),
+	),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+)
+
+// Render: source'u HTML'e cevirir ve basliklari (belgedeki sirayla) dondurur.
+func Render(source []byte) (string, []Heading, error) {
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{used: map[string]bool{}}))
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+
+	var toc []Heading
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		id, _ := h.AttributeString("id")
+		idBytes, _ := id.([]byte)
+		toc = append(toc, Heading{Level: h.Level, ID: string(idBytes), Text: plainText(h, source)})
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := md.Renderer().Render(&buf, source, doc); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), toc, nil
+}
+
+// wrapCode: kod blogunu <pre class="chroma"><code class="language-x"> ile sarar (renklendirilemeyen diller dahil).
+func wrapCode(w util.BufWriter, c highlighting.CodeBlockContext, entering bool) {
+	if !entering {
+		_, _ = w.WriteString("</code></pre>\n")
+		return
+	}
+
+	_, _ = w.WriteString(`<pre class="chroma"><code`)
+	if lang, ok := c.Language(); ok {
+		_, _ = w.WriteString(` class="language-` + html.EscapeString(string(lang)) + `"`)
+	}
+	_, _ = w.WriteString(">")
+}
+
+// plainText: basligin duz metni (vurgu, link ve kod isaretleri olmadan).
+func plainText(n ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	_ = ast.Walk(n, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch t := n.(type) {
+		case *ast.Text:
+			buf.Write(t.Segment.Value(source))
+			if t.SoftLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(t.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return buf.String()
+}
+
+// headingIDs: baslik id'lerini pkg/slug ile uretir ("Kurulum Adımları" -> "kurulum-adimlari").
+// Tekrarlanan basliklara -1, -2 eklenir; harf/rakam icermeyen basliklar "section" olur.
+type headingIDs struct {
+	used map[string]bool
+}
+
+func (ids *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	base := slug.Make(string(value))
+	if base == "" {
+		base = "section"
+	}
+
+	id := base
+	for i := 1; ids.used[id]; i++ {
+		id = base + "-" + strconv.Itoa(i)
+	}
+	ids.used[id] = true
+	return []byte(id)
+}
+
+func (ids *headingIDs) Put(value []byte) {
+	ids.used[string(value)] = true
+}
@@ -0,0 +1,74 @@
+// Package ratelimit: bellekte tutulan kayan pencere (sliding window) hiz sinirlayici. Sayaclar surece ozeldir;
+// birden fazla instance calisiyorsa her instance kendi sinirini uygular.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// cleanupEvery: bu kadar Allow cagrisinda bir, penceresi dolmus anahtarlar silinir.
+const cleanupEvery = 1000
+
+// Limiter: her anahtar (orn: "ip:1.2.3.4", "user:42") icin window suresinde en fazla limit istek.
+type Limiter struct {
+	limit  int
+	window time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	hits  map[string][]time.Time // anahtarin pencere icindeki istek zamanlari, eskiden yeniye
+	calls int
+}
+
+// New: limit <= 0 ise sinir uygulanmaz (Allow her zaman true doner).
+func New(limit int, window time.Duration) *Limiter {
+	return &Limiter{limit: limit, window: window, now: time.Now, hits: map[string][]time.Time{}}
+}
+
+// Allow: key icin pencere icindeki istek sayisi limit'in altindaysa istegi sayar ve true doner. Degilse istek
+// sayilmaz; ikinci deger en eski istegin pencereden cikmasina kalan suredir (Retry-After).
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	if l.limit <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.calls++
+	if l.calls%cleanupEvery == 0 {
+		l.cleanup(now)
+	}
+
+	hits := l.recent(key, now)
+	if len(hits) >= l.limit {
+		l.hits[key] = hits
+		return false, hits[0].Add(l.window).Sub(now)
+	}
+
+	l.hits[key] = append(hits, now)
+	return true, 0
+}
+
+// recent: key'in pencere icinde kalan istekleri.
+func (l *Limiter) recent(key string, now time.Time) []time.Time {
+	hits := l.hits[key]
+	cutoff := now.Add(-l.window)
+	i := 0
+	for i < len(hits) && !hits[i].After(cutoff) {
+		i++
+	}
+	return hits[i:]
+}
+
+func (l *Limiter) cleanup(now time.Time) {
+	for key := range l.hits {
+		if hits := l.recent(key, now); len(hits) == 0 {
+			delete(l.hits, key)
+		} else {
+			l.hits[key] = hits
+		}
+	}
+}
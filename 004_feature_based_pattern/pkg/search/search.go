@@ -0,0 +1,216 @@
+// Package search: veritabaninda tam metin arama olmayan ortamlar (sqlite, testler) icin bellekte tutulan basit ters indeks.
+// Kelimeler pkg/slug ile katlanir ("Guncelleme", "güncelleme" -> "guncelleme"); sorgu kelimeleri on ek olarak eslesir,
+// boylece "makale" aramasi "makaleler", "makalenin" gibi cekimli halleri de bulur (Turkce kok bulmanin kaba bir karsiligi).
+package search
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"feature-base-starter-kit/pkg/slug"
+)
+
+// minPrefixLen: bundan kisa sorgu kelimeleri sadece tam eslesir ("go" -> "google" eslesmesin).
+const minPrefixLen = 3
+
+// Alan agirliklari, postgres ts_rank varsayilanlariyla ayni (A: 1.0, B: 0.4, C: 0.2).
+const (
+	WeightA = 1.0
+	WeightB = 0.4
+	WeightC = 0.2
+)
+
+// Field: indekslenecek metin ve agirligi.
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Hit: arama sonucu, Score'a gore azalan sirada doner.
+type Hit struct {
+	ID    int64
+	Score float64
+}
+
+// Index: eszamanli kullanima uygun ters indeks (kelime -> dokuman -> agirlikli frekans).
+type Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[int64]float64
+	terms    map[int64][]string // Remove icin dokumanin kelimeleri
+}
+
+func NewIndex() *Index {
+	return &Index{postings: map[string]map[int64]float64{}, terms: map[int64][]string{}}
+}
+
+// Put: dokumani ekler, ayni id varsa once eskisini kaldirir.
+func (ix *Index) Put(id int64, fields ...Field) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(id)
+
+	freq := map[string]float64{}
+	for _, f := range fields {
+		for _, t := range Tokenize(StripHTML(f.Text)) {
+			freq[t] += f.Weight
+		}
+	}
+
+	terms := make([]string, 0, len(freq))
+	for t, w := range freq {
+		if ix.postings[t] == nil {
+			ix.postings[t] = map[int64]float64{}
+		}
+		ix.postings[t][id] = w
+		terms = append(terms, t)
+	}
+	ix.terms[id] = terms
+}
+
+func (ix *Index) Remove(id int64) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.remove(id)
+}
+
+func (ix *Index) remove(id int64) {
+	for _, t := range ix.terms[id] {
+		delete(ix.postings[t], id)
+		if len(ix.postings[t]) == 0 {
+			delete(ix.postings, t)
+		}
+	}
+	delete(ix.terms, id)
+}
+
+// Search: sorgudaki tum kelimeleri iceren dokumanlari dondurur (AND). Tam eslesme on ek eslesmesinden yuksek puan alir.
+func (ix *Index) Search(query string) []Hit {
+	queryTerms := Tokenize(query)
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	var scores map[int64]float64
+	for _, qt := range queryTerms {
+		termScores := map[int64]float64{}
+		for t, docs := range ix.postings {
+			factor := 0.0
+			switch {
+			case t == qt:
+				factor = 1
+			case len(qt) >= minPrefixLen && strings.HasPrefix(t, qt):
+				factor = 0.5
+			default:
+				continue
+			}
+			for id, w := range docs {
+				termScores[id] = math.Max(termScores[id], w*factor)
+			}
+		}
+
+		if scores == nil {
+			scores = termScores
+			continue
+		}
+		for id := range scores {
+			if s, ok := termScores[id]; ok {
+				scores[id] += s
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, s := range scores {
+		hits = append(hits, Hit{ID: id, Score: s})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	return hits
+}
+
+// Tokenize: metni katlanmis kelimelere boler. Latin disi alfabelerdeki kelimeler (pkg/slug'in cevirmedigi) kucuk harfe cevrilerek korunur.
+func Tokenize(s string) []string {
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+
+	tokens := make([]string, 0, len(words))
+	for _, w := range words {
+		if t := fold(w); t != "" {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens
+}
+
+func fold(word string) string {
+	if folded := slug.Make(word); folded != "" {
+		return strings.ReplaceAll(folded, "-", "")
+	}
+	return strings.ToLower(word)
+}
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// StripHTML: etiketleri bosluga cevirir ve entity'leri cozer (postgres tarafindaki regexp_replace ile ayni kural).
+func StripHTML(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(tagPattern.ReplaceAllString(s, " "))), " ")
+}
+
+// Highlight: text'te sorgu kelimeleriyle eslesen kelimeleri <b>...</b> ile isaretler ve ilk eslesme cevresinden
+// en fazla maxWords kelimelik bir parca dondurur (ts_headline benzeri). Sonuc HTML'dir, metin escape edilir.
+func Highlight(text, query string, maxWords int) string {
+	queryTerms := Tokenize(query)
+	words := strings.Fields(StripHTML(text))
+
+	first := -1
+	marked := make([]bool, len(words))
+	for i, w := range words {
+		for _, t := range Tokenize(w) {
+			if matches(t, queryTerms) {
+				marked[i] = true
+			}
+		}
+		if marked[i] && first < 0 {
+			first = i
+		}
+	}
+
+	start := max(0, first-maxWords/4)
+	end := min(len(words), start+maxWords)
+
+	var b strings.Builder
+	for i := start; i < end; i++ {
+		if i > start {
+			b.WriteByte(' ')
+		}
+		if marked[i] {
+			b.WriteString("<b>" + html.EscapeString(words[i]) + "</b>")
+		} else {
+			b.WriteString(html.EscapeString(words[i]))
+		}
+	}
+	return b.String()
+}
+
+func matches(token string, queryTerms []string) bool {
+	for _, qt := range queryTerms {
+		if token == qt || (len(qt) >= minPrefixLen && strings.HasPrefix(token, qt)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package seed
+
+// Uretilen verilerin kaynak listeleri. Liste sirasi degisirse ayni seed farkli veri uretir.
+
+var firstNames = []string{
+	"Ahmet", "Ayşe", "Mehmet", "Fatma", "Mustafa", "Zeynep", "Ali", "Elif", "Hüseyin", "Emine",
+	"Okan", "Sercan", "Alin", "Deniz", "Cem", "Selin", "Burak", "Gizem", "Emre", "Merve",
+	"Can", "Ece", "Kerem", "İrem", "Oğuz", "Şule", "Tolga", "Çağla", "Uğur", "Özge",
+}
+
+var lastNames = []string{
+	"Yılmaz", "Kaya", "Demir", "Şahin", "Çelik", "Yıldız", "Yıldırım", "Öztürk", "Aydın", "Özdemir",
+	"Arslan", "Doğan", "Kılıç", "Aslan", "Çetin", "Kara", "Koç", "Kurt", "Özkan", "Şimşek",
+	"Aras", "Özen", "Polat", "Erdoğan", "Güneş", "Aksoy", "Tekin", "Bulut", "Keskin", "Ünal",
+}
+
+// categoryNames: ilk kategoriler postgres.sql'deki ornek kategorilerle aynidir.
+var categoryNames = []string{
+	"Go Programlama", "Veritabanı", "Web Geliştirme", "DevOps", "Mimari", "Test",
+	"Güvenlik", "Performans", "Bulut", "Mobil", "Yapay Zeka", "Kariyer",
+}
+
+var topics = []string{
+	"Go", "PostgreSQL", "MySQL", "SQLite", "Gin", "Docker", "Kubernetes", "Redis",
+	"REST API", "gRPC", "JSON", "Middleware", "Validation", "Transaction", "Index", "Goroutine",
+}
+
+var titleTemplates = []string{
+	"%s ile %s Kullanımı",
+	"%s ve %s Rehberi",
+	"%s için %s İpuçları",
+	"%s Üzerinde %s Performansı",
+	"Adım Adım %s ve %s",
+	"%s ile %s Entegrasyonu",
+}
+
+var sentences = []string{
+	"Bu makalede %s konusunu örneklerle inceliyoruz.",
+	"%s kurulumu için öncelikle gerekli bağımlılıkları yüklememiz gerekir.",
+	"Gerçek projelerde %s kullanırken dikkat edilmesi gereken noktaları ele alacağız.",
+	"%s ile ilgili sık yapılan hataları ve çözümlerini derledik.",
+	"Yazının sonunda %s için küçük bir örnek proje geliştireceğiz.",
+	"%s tarafında performansı ölçmek için basit bir benchmark yazıyoruz.",
+}
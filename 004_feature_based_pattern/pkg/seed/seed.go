@@ -0,0 +1,222 @@
+// Package seed: gelistirme ve demo ortamlari icin tekrarlanabilir (deterministik) ornek veri uretir.
+//
+// Ayni Options ile her calistirmada ayni kayitlar uretilir. Kayitlar dogal anahtarlarina gore
+// (users.email, categories.slug, articles.slug, article_categories) upsert edildigi icin komut tekrar
+// calistirildiginda kopya olusmaz, mevcut kayitlar guncellenir.
+package seed
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"feature-base-starter-kit/pkg/database"
+	"feature-base-starter-kit/pkg/slug"
+)
+
+// Options: uretilecek veri miktari ve rastgelelik kaynagi.
+type Options struct {
+	Users      int
+	Categories int // en fazla len(categoryNames) kadar isimli kategori, fazlasi numaralandirilir
+	Articles   int
+	// MaxCategoriesPerArticle: her makale 1 ile bu sayi arasinda kategoriye baglanir.
+	MaxCategoriesPerArticle int
+	Seed                    uint64
+}
+
+// seedEpoch: ornek makalelerin yayin zamanlari bu tarihten itibaren gunluk artar.
+var seedEpoch = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// DefaultOptions: "seed" komutunun varsayilan degerleri.
+func DefaultOptions() Options {
+	return Options{Users: 20, Categories: 6, Articles: 50, MaxCategoriesPerArticle: 3, Seed: 1}
+}
+
+// Result: yazilan (eklenen veya guncellenen) kayit sayilari.
+type Result struct {
+	Users      int
+	Categories int
+	Articles   int
+	Links      int
+}
+
+func (r Result) String() string {
+	return fmt.Sprintf("users=%d categories=%d articles=%d article_categories=%d", r.Users, r.Categories, r.Articles, r.Links)
+}
+
+// Run: verileri tek bir transaction icinde yazar. Hata olursa hicbir kayit yazilmaz.
+func Run(ctx context.Context, db *database.DB, opts Options) (Result, error) {
+	if opts.Articles > 0 && (opts.Users < 1 || opts.Categories < 1) {
+		return Result{}, fmt.Errorf("seed: articles need at least one user and one category")
+	}
+
+	g := &generator{
+		db:  db,
+		rnd: rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x9e3779b97f4a7c15)),
+	}
+
+	var res Result
+	err := database.NewTxManager(db).WithinTx(ctx, func(ctx context.Context) error {
+		userIDs, err := g.users(ctx, opts.Users)
+		if err != nil {
+			return fmt.Errorf("seed users: %w", err)
+		}
+
+		categoryIDs, err := g.categories(ctx, opts.Categories)
+		if err != nil {
+			return fmt.Errorf("seed categories: %w", err)
+		}
+
+		links, err := g.articles(ctx, opts.Articles, max(opts.MaxCategoriesPerArticle, 1), userIDs, categoryIDs)
+		if err != nil {
+			return fmt.Errorf("seed articles: %w", err)
+		}
+
+		res = Result{Users: len(userIDs), Categories: len(categoryIDs), Articles: opts.Articles, Links: links}
+		return nil
+	})
+
+	return res, err
+}
+
+type generator struct {
+	db  *database.DB
+	rnd *rand.Rand
+}
+
+func (g *generator) pick(list []string) string {
+	return list[g.rnd.IntN(len(list))]
+}
+
+// upsertID: kaydi upsert eder ve dogal anahtarina gore id'sini okur.
+// (Upsert'te RETURNING/LastInsertId dialect'ler arasinda tutarli degil: mysql guncellemede id dondurmez.)
+func (g *generator) upsertID(ctx context.Context, table string, columns, update []string, key string, args ...any) (int64, error) {
+	q := g.db.Dialect.Upsert(table, columns, []string{key}, update)
+	if _, err := g.db.ExecContext(ctx, q, args...); err != nil {
+		return 0, err
+	}
+
+	keyValue := args[slices.Index(columns, key)]
+
+	var id int64
+	err := g.db.QueryRowContext(ctx, fmt.Sprintf("SELECT id FROM %s WHERE %s = ?", g.db.Dialect.Quote(table), g.db.Dialect.Quote(key)), keyValue).Scan(&id)
+	return id, err
+}
+
+func (g *generator) users(ctx context.Context, n int) ([]int64, error) {
+	ids := make([]int64, 0, n)
+
+	for i := 1; i <= n; i++ {
+		first, last := g.pick(firstNames), g.pick(lastNames)
+		name := first + " " + last
+		// index email'i benzersiz yapar, ayni seed ile ayni kullanici ayni email'i alir
+		email := fmt.Sprintf("%s.%s%d@example.com", slug.Make(first), slug.Make(last), i)
+		age := 18 + g.rnd.IntN(50)
+		active := g.rnd.IntN(10) > 0 // ~%10 pasif
+
+		id, err := g.upsertID(ctx, "users",
+			[]string{"name", "email", "age", "is_active"}, []string{"name", "age", "is_active"},
+			"email", name, email, age, active)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (g *generator) categories(ctx context.Context, n int) ([]int64, error) {
+	ids := make([]int64, 0, n)
+
+	for i := range n {
+		name := categoryNames[i%len(categoryNames)]
+		if i >= len(categoryNames) {
+			name = fmt.Sprintf("%s %d", name, i/len(categoryNames)+1)
+		}
+
+		id, err := g.upsertID(ctx, "categories",
+			[]string{"name", "slug", "is_active"}, []string{"name", "is_active"},
+			"slug", name, slug.Make(name), true)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (g *generator) articles(ctx context.Context, n, maxCategories int, userIDs, categoryIDs []int64) (int, error) {
+	linkQuery := g.db.Dialect.Upsert("article_categories",
+		[]string{"article_id", "category_id"}, []string{"article_id", "category_id"}, nil)
+
+	links := 0
+	for i := 1; i <= n; i++ {
+		a, b := g.pick(topics), g.pick(topics)
+		title := truncateRunes(fmt.Sprintf(g.pick(titleTemplates), a, b), 50)
+
+		// makale numarasi slug'i benzersiz yapar: "go-ile-docker-kullanimi-12"
+		suffix := fmt.Sprintf("-%d", i)
+		articleSlug := slug.Truncate(slug.Make(title), 60-len(suffix)) + suffix
+
+		short := truncateRunes(fmt.Sprintf(sentences[0], a), 150)
+		description := g.description(a, b)
+		active := g.rnd.IntN(5) > 0
+		// aktif makaleler yayinlanmis kabul edilir; yayin zamani makale numarasindan uretilir (tekrar calistirmada ayni kalir)
+		status, publishedAt := "draft", sql.NullTime{}
+		if active {
+			status, publishedAt = "published", sql.NullTime{Time: seedEpoch.AddDate(0, 0, i), Valid: true}
+		}
+		userID := userIDs[g.rnd.IntN(len(userIDs))]
+
+		seo, err := json.Marshal(map[string]any{
+			"meta_title":       title,
+			"meta_description": short,
+			"keywords":         []string{slug.Make(a), slug.Make(b)},
+		})
+		if err != nil {
+			return links, err
+		}
+
+		id, err := g.upsertID(ctx, "articles",
+			[]string{"title", "slug", "short_description", "description", "is_active", "user_id", "seo_settings", "status", "published_at"},
+			[]string{"title", "short_description", "description", "is_active", "user_id", "seo_settings", "status", "published_at"},
+			"slug", title, articleSlug, short, description, active, userID, string(seo), status, publishedAt)
+		if err != nil {
+			return links, err
+		}
+
+		// farkli kategoriler: karistirilmis listenin ilk k elemani
+		k := 1 + g.rnd.IntN(min(maxCategories, len(categoryIDs)))
+		for _, j := range g.rnd.Perm(len(categoryIDs))[:k] {
+			if _, err := g.db.ExecContext(ctx, linkQuery, id, categoryIDs[j]); err != nil {
+				return links, err
+			}
+			links++
+		}
+	}
+
+	return links, nil
+}
+
+func (g *generator) description(topics ...string) string {
+	var b strings.Builder
+	for range 2 + g.rnd.IntN(3) {
+		fmt.Fprintf(&b, "<p>"+g.pick(sentences)+"</p>", topics[g.rnd.IntN(len(topics))])
+	}
+	return b.String()
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n]))
+}
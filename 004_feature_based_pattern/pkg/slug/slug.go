@@ -0,0 +1,57 @@
+// Package slug: metinden URL'de kullanilabilecek slug uretir ("Go ve PostgreSQL Rehberi" -> "go-ve-postgresql-rehberi").
+// Uretilen slug validation paketindeki "slug" kuralini saglar.
+package slug
+
+import "strings"
+
+// translit: Turkce ve yaygin Latin harflerin ASCII karsiliklari. Listede olmayan harfler atlanir.
+var translit = map[rune]string{
+	'ç': "c", 'ğ': "g", 'ı': "i", 'i': "i", 'ö': "o", 'ş': "s", 'ü': "u",
+	'Ç': "c", 'Ğ': "g", 'I': "i", 'İ': "i", 'Ö': "o", 'Ş': "s", 'Ü': "u",
+	'â': "a", 'î': "i", 'û': "u", 'ä': "a", 'ß': "ss", 'é': "e", 'è': "e", 'ê': "e",
+	'á': "a", 'à': "a", 'ñ': "n", 'ó': "o", 'ò': "o", 'ú': "u", 'ù': "u", 'ə': "e", 'Ə': "e",
+}
+
+// Make: s'i kucuk harfli, tireyle ayrilmis ASCII slug'a cevirir. Harf/rakam icermiyorsa bos string doner.
+func Make(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	dash := false
+	for _, r := range s {
+		var part string
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			part = string(r)
+		case r >= 'A' && r <= 'Z':
+			part = string(r + ('a' - 'A'))
+		default:
+			part = translit[r]
+		}
+
+		if part == "" {
+			dash = b.Len() > 0
+			continue
+		}
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteString(part)
+	}
+
+	return b.String()
+}
+
+// Truncate: slug'i (ASCII) en fazla max karakter olacak sekilde, kelime (tire) sinirindan keser.
+func Truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	s = s[:max]
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+	return strings.TrimSuffix(s, "-")
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Local: dosyalari dir altinda saklar. Content-Type dosya uzantisindan bulunur, bu yuzden key'ler uzantili olmalidir.
+type Local struct {
+	dir string
+}
+
+// NewLocal: dir yoksa olusturur.
+func NewLocal(dir string) (*Local, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("storage: create %s: %w", dir, err)
+	}
+	return &Local{dir: dir}, nil
+}
+
+func (l *Local) path(key string) (string, error) {
+	if !ValidKey(key) {
+		return "", fmt.Errorf("storage: invalid key %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+// Put: once gecici dosyaya yazilir, sonra yeniden adlandirilir; okuyucular yarim dosya gormez.
+func (l *Local) Put(_ context.Context, key string, r io.Reader, size int64, _ string) error {
+	p, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // rename basariliysa dosya yoktur, hata doner ve yok sayilir
+
+	n, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if size >= 0 && n != size {
+		return fmt.Errorf("storage: wrote %d bytes, expected %d", n, size)
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+func (l *Local) Open(_ context.Context, key string) (io.ReadSeekCloser, Object, error) {
+	p, err := l.path(key)
+	if err != nil {
+		return nil, Object{}, ErrNotFound
+	}
+
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, Object{}, ErrNotFound
+	}
+	if err != nil {
+		return nil, Object{}, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, Object{}, err
+	}
+	if info.IsDir() {
+		f.Close()
+		return nil, Object{}, ErrNotFound
+	}
+
+	return f, Object{Size: info.Size(), ContentType: mime.TypeByExtension(path.Ext(key)), ModTime: info.ModTime()}, nil
+}
+
+func (l *Local) Delete(_ context.Context, key string) error {
+	p, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Ping: dizin var ve dizin mi.
+func (l *Local) Ping(context.Context) error {
+	info, err := os.Stat(l.dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("storage: %s is not a directory", l.dir)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config: S3 uyumlu servis. Endpoint sema icermez (orn: "s3.amazonaws.com", "localhost:9000").
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+// S3: dosyalari bir bucket'ta saklar (AWS S3, MinIO, Cloudflare R2, ...). Content-Type nesne metadata'sinda tutulur.
+type S3 struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3: istemciyi olusturur ve bucket yoksa olusturur (yerel MinIO ile gelistirme icin, bkz. gin-docker/docker-compose.yaml).
+func NewS3(ctx context.Context, cfg S3Config) (*S3, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage: s3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("storage: s3 bucket %s: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, cfg.Bucket, minio.MakeBucketOptions{Region: cfg.Region}); err != nil {
+			return nil, fmt.Errorf("storage: create s3 bucket %s: %w", cfg.Bucket, err)
+		}
+	}
+
+	return &S3{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if !ValidKey(key) {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+// Open: minio.Object istek gonderilmeden olusturulur, nesnenin varligi Stat ile kontrol edilir.
+func (s *S3) Open(ctx context.Context, key string) (io.ReadSeekCloser, Object, error) {
+	if !ValidKey(key) {
+		return nil, Object{}, ErrNotFound
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, Object{}, s.mapError(err)
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, Object{}, s.mapError(err)
+	}
+
+	return obj, Object{Size: info.Size, ContentType: info.ContentType, ModTime: info.LastModified}, nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	// S3, olmayan nesne silinirken de basarili doner
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3) Ping(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("storage: s3 bucket %s does not exist", s.bucket)
+	}
+	return nil
+}
+
+func (s *S3) mapError(err error) error {
+	if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
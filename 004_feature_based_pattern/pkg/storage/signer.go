@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Signer: dosya URL'lerini HMAC-SHA256 ile imzalar: <baseURL>/files/<key>?expires=<unix>&signature=<hex>.
+// Imza key ve expires'i kapsar; URL'i bilen herkes dosyayi expires'a kadar indirebilir.
+type Signer struct {
+	secret  []byte
+	ttl     time.Duration
+	baseURL string
+	now     func() time.Time
+}
+
+// NewSigner: baseURL bos ise URL'ler goreli uretilir ("/files/...").
+func NewSigner(secret string, ttl time.Duration, baseURL string) *Signer {
+	return &Signer{secret: []byte(secret), ttl: ttl, baseURL: strings.TrimSuffix(baseURL, "/"), now: time.Now}
+}
+
+// URL: key icin en az ttl sure gecerli imzali URL ve bitis zamani. Bitis zamani ttl'in yarisinin katlarina yuvarlanir;
+// boylece ayni dosya icin ardisik isteklerde ayni URL uretilir ve tarayici/CDN onbellekleri kullanilabilir.
+func (s *Signer) URL(key string) (string, time.Time) {
+	step := max(s.ttl/2, time.Second)
+	expires := s.now().Add(s.ttl).Truncate(step).Add(step)
+
+	q := url.Values{}
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("signature", s.sign(key, expires.Unix()))
+	return s.baseURL + "/files/" + key + "?" + q.Encode(), expires
+}
+
+// Verify: imza gecerli ve sure dolmamissa true.
+func (s *Signer) Verify(key, expires, signature string) bool {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > exp {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(s.sign(key, exp)))
+}
+
+func (s *Signer) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
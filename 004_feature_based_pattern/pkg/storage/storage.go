@@ -0,0 +1,52 @@
+// Package storage: yuklenen dosyalarin saklandigi yer. Local (dosya sistemi) ve S3 uyumlu (AWS S3, MinIO, ...)
+// implementasyonlar ayni Storage interface'ini saglar. Dosyalar dogrudan degil, imzali ve sureli URL'lerle sunulur (bkz. Signer).
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// ErrNotFound: key altinda dosya yok.
+var ErrNotFound = errors.New("storage: object not found")
+
+// Object: saklanan dosyanin bilgileri.
+type Object struct {
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Storage: key'ler "/" ile ayrilmis goreli yollardir (orn: "avatars/42/3f9a.../original.png"), bkz. ValidKey.
+type Storage interface {
+	// Put: r'den size byte okuyup key altina yazar, varsa uzerine yazar.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Open: dosyayi okumak icin acar (Range istekleri icin Seek destekler), yoksa ErrNotFound. Cagiran Close etmelidir.
+	Open(ctx context.Context, key string) (io.ReadSeekCloser, Object, error)
+	// Delete: dosyayi siler, dosya yoksa hata donmez.
+	Delete(ctx context.Context, key string) error
+	// Ping: depolamaya erisilebiliyor mu (readiness kontrolu, bkz. pkg/health).
+	Ping(ctx context.Context) error
+}
+
+// ValidKey: key bos degil, "/" ile baslamiyor ve sadece [a-z0-9._-] iceren parcalardan olusuyor ("." ve ".." haric).
+// Imzali URL'lerden gelen key'lerin dizin disina cikmasini engeller.
+func ValidKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return false
+		}
+		for _, r := range part {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
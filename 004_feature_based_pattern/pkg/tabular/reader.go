@@ -0,0 +1,141 @@
+// Package tabular: CSV ve XLSX tablolarini satir satir okur, CSV, NDJSON ve XLSX olarak yazar. Import ve export
+// endpoint'leri dosyayi bellege almadan (XLSX haric, bkz. NewReader ve NewWriter) isler.
+package tabular
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format: tablo dosyasinin turu.
+type Format string
+
+const (
+	CSV    Format = "csv"
+	NDJSON Format = "ndjson" // sadece yazma, her satir bir JSON nesnesi
+	XLSX   Format = "xlsx"
+)
+
+// Content-Type'lar (istek ve cevap icin).
+const (
+	ContentTypeCSV    = "text/csv"
+	ContentTypeNDJSON = "application/x-ndjson"
+	ContentTypeXLSX   = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// unzipRatio: XLSX (zip) acildiginda dosya boyutunun en fazla bu kati kadar veri acilir (zip bomb korumasi).
+const unzipRatio = 100
+
+// FormatFromContentType: "text/csv; charset=utf-8" gibi degerlerden format. application/csv ve
+// application/vnd.ms-excel (Windows'ta .csv icin gonderilir) da CSV sayilir.
+func FormatFromContentType(contentType string) (Format, bool) {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch strings.ToLower(strings.TrimSpace(mediaType)) {
+	case ContentTypeCSV, "application/csv", "application/vnd.ms-excel":
+		return CSV, true
+	case ContentTypeXLSX:
+		return XLSX, true
+	}
+	return "", false
+}
+
+// FormatFromFilename: dosya uzantisindan format (.csv, .xlsx).
+func FormatFromFilename(name string) (Format, bool) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return CSV, true
+	case ".xlsx":
+		return XLSX, true
+	}
+	return "", false
+}
+
+// Reader: tablonun satirlari, ilk satir genellikle basliktir. Satirlarin hucre sayisi farkli olabilir.
+type Reader interface {
+	// Read: siradaki satir, satir kalmadiysa io.EOF.
+	Read() ([]string, error)
+	Close() error
+}
+
+// NewReader: CSV r'den satir satir okunur. XLSX bir zip arsivi oldugu icin dosya once tamamen okunur
+// (maxSize ile sinirlanmali, orn: http.MaxBytesReader), satirlar ilk calisma sayfasindan okunur.
+func NewReader(format Format, r io.Reader, maxSize int64) (Reader, error) {
+	switch format {
+	case CSV:
+		cr := csv.NewReader(bufio.NewReader(r))
+		cr.FieldsPerRecord = -1
+		return &csvReader{r: cr}, nil
+	case XLSX:
+		return newXLSXReader(r, maxSize)
+	}
+	return nil, fmt.Errorf("tabular: unsupported format %q", format)
+}
+
+type csvReader struct {
+	r       *csv.Reader
+	started bool
+}
+
+func (c *csvReader) Read() ([]string, error) {
+	record, err := c.r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	// Excel'in UTF-8 CSV'lerinin basindaki BOM
+	if !c.started {
+		c.started = true
+		if len(record) > 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+		}
+	}
+	return record, nil
+}
+
+func (c *csvReader) Close() error { return nil }
+
+type xlsxReader struct {
+	file *excelize.File
+	rows *excelize.Rows
+}
+
+func newXLSXReader(r io.Reader, maxSize int64) (*xlsxReader, error) {
+	f, err := excelize.OpenReader(r, excelize.Options{UnzipSizeLimit: maxSize * unzipRatio})
+	if err != nil {
+		return nil, err
+	}
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		f.Close()
+		return nil, errors.New("tabular: workbook has no sheets")
+	}
+	rows, err := f.Rows(sheets[0])
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &xlsxReader{file: f, rows: rows}, nil
+}
+
+// Read: hucrelerin bicimlendirilmis degerleri (ekranda gorundugu gibi); bos satirlar bos kayit olarak doner.
+func (x *xlsxReader) Read() ([]string, error) {
+	if !x.rows.Next() {
+		if err := x.rows.Error(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	return x.rows.Columns()
+}
+
+func (x *xlsxReader) Close() error {
+	return errors.Join(x.rows.Close(), x.file.Close())
+}
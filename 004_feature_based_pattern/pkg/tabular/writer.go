@@ -0,0 +1,224 @@
+package tabular
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Writer: basliktan sonra satirlari yazar. Degerler nil, string, bool, int, int64, float64, time.Time veya []string
+// olabilir; diger tipler JSON olarak yazilir. nil CSV/XLSX'te bos hucre, NDJSON'da null olur.
+type Writer interface {
+	WriteHeader(columns []string) error
+	WriteRow(values []any) error
+	// Close: tamponu bosaltir; XLSX'te dosya bu asamada w'ye yazilir. w'yi kapatmaz.
+	Close() error
+}
+
+// NewWriter: CSV ve NDJSON satirlari w'ye akitilir. XLSX satirlari excelize'in StreamWriter'i ile (buyuk tablolarda
+// gecici dosyaya) tamponlanir ve Close'ta yazilir.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case NDJSON:
+		return &ndjsonWriter{w: bufio.NewWriter(w)}, nil
+	case XLSX:
+		return newXLSXWriter(w)
+	}
+	return nil, fmt.Errorf("tabular: unsupported format %q", format)
+}
+
+// ContentType: format'in cevapta gonderilecek Content-Type'i.
+func (f Format) ContentType() string {
+	switch f {
+	case CSV:
+		return ContentTypeCSV + "; charset=utf-8"
+	case NDJSON:
+		return ContentTypeNDJSON
+	case XLSX:
+		return ContentTypeXLSX
+	}
+	return "application/octet-stream"
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteHeader(columns []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvWriter) WriteRow(values []any) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		s, err := text(v)
+		if err != nil {
+			return err
+		}
+		record[i] = escapeFormula(s, v)
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula: "=", "+", "-", "@" ile baslayan metinler Excel'de formul olarak calistirilmasin diye "'" ile
+// baslatilir (CSV injection). Sayilar ve tarihler degistirilmez.
+func escapeFormula(s string, v any) string {
+	if _, ok := v.(string); !ok || s == "" {
+		return s
+	}
+	if strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (n *ndjsonWriter) WriteHeader(columns []string) error {
+	n.columns = columns
+	return nil
+}
+
+// WriteRow: kolon sirasi korunur, bu yuzden map yerine nesne elle yazilir.
+func (n *ndjsonWriter) WriteRow(values []any) error {
+	n.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			n.w.WriteByte(',')
+		}
+		key, _ := marshal(n.columns[i])
+		val, err := marshal(v)
+		if err != nil {
+			return err
+		}
+		n.w.Write(key)
+		n.w.WriteByte(':')
+		n.w.Write(val)
+	}
+	n.w.WriteString("}\n")
+	return nil
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
+
+type xlsxWriter struct {
+	out   io.Writer
+	file  *excelize.File
+	sheet *excelize.StreamWriter
+	row   int
+	date  int // tarih hucrelerinin stili
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	sw, err := f.NewStreamWriter("Sheet1")
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	date, err := f.NewStyle(&excelize.Style{NumFmt: 22}) // m/d/yy h:mm, Excel'de yerel bicimde gosterilir
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &xlsxWriter{out: w, file: f, sheet: sw, date: date}, nil
+}
+
+func (x *xlsxWriter) WriteHeader(columns []string) error {
+	cells := make([]any, len(columns))
+	for i, c := range columns {
+		cells[i] = c
+	}
+	return x.setRow(cells)
+}
+
+func (x *xlsxWriter) WriteRow(values []any) error {
+	cells := make([]any, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case nil, string, bool, int, int64, float64:
+			cells[i] = v
+		case time.Time:
+			cells[i] = excelize.Cell{StyleID: x.date, Value: v.UTC()}
+		default:
+			s, err := text(v)
+			if err != nil {
+				return err
+			}
+			cells[i] = s
+		}
+	}
+	return x.setRow(cells)
+}
+
+func (x *xlsxWriter) setRow(cells []any) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.sheet.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.sheet.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+// marshal: json.Marshal, ancak HTML karakterleri (<, >, &) kacislanmaz; makale description'lari okunabilir kalir.
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// text: degerin CSV hucresindeki hali. Zamanlar RFC 3339 (UTC), listeler ";" ile birlestirilir.
+func text(v any) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano), nil
+	case []string:
+		return strings.Join(v, ";"), nil
+	}
+	b, err := marshal(v)
+	return string(b), err
+}
@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Veritabanina sorgu atmasi gereken kurallar (unique, exists) binding sirasinda degil,
+// ayri bir "async" tag'i ile ve request context'i ile calistirilir:
+//
+//	type CreateUserRequest struct {
+//		Email string `json:"email" binding:"required,email" async:"unique=users.email"`
+//	}
+//
+//	if err := validation.ValidateAsync(c.Request.Context(), &req); err != nil { ... }
+//
+// Boylece gin'in ShouldBindJSON adiminda DB'ye gidilmez ve istek iptal edildiginde sorgu da iptal olur.
+
+// RecordChecker: unique ve exists kurallarinin kullandigi arayuz. Repository katmani tarafindan saglanir.
+type RecordChecker interface {
+	// Exists: table.column = value olan bir kayit var mi?
+	Exists(ctx context.Context, table, column string, value any) (bool, error)
+}
+
+var (
+	checker       RecordChecker
+	asyncValidate *validator.Validate
+)
+
+// SetRecordChecker: unique/exists kurallarinin kullanacagi checker'i ayarlar. nil ise bu kurallar atlanir.
+func SetRecordChecker(c RecordChecker) {
+	checker = c
+}
+
+// asyncState: tek bir ValidateAsync cagrisi boyunca olusan altyapi hatasini (DB hatasi, iptal vb.) tasir.
+// validator.FuncCtx sadece bool dondurebildigi icin, hata context uzerinden disari tasinir.
+type asyncState struct {
+	mu  sync.Mutex
+	err error
+}
+
+type asyncStateKey struct{}
+
+func initAsync() {
+	v := validator.New()
+	v.SetTagName("async")
+	v.RegisterTagNameFunc(jsonTagName)
+
+	mustRegisterAsync(v, "unique", func(ctx context.Context, fl validator.FieldLevel) bool {
+		found, ok := lookup(ctx, fl)
+		return !ok || !found
+	})
+	mustRegisterAsync(v, "exists", func(ctx context.Context, fl validator.FieldLevel) bool {
+		found, ok := lookup(ctx, fl)
+		return !ok || found
+	})
+
+	asyncValidate = v
+}
+
+func mustRegisterAsync(v *validator.Validate, tag string, fn validator.FuncCtx) {
+	// mesajlar locales/*.yaml icindeki "unique" ve "exists" anahtarlarindan gelir
+	if err := v.RegisterValidationCtx(tag, fn); err != nil {
+		panic(err)
+	}
+}
+
+// lookup: kural parametresini (table.column) cozer ve checker'a sorar.
+// ok=false ise sonuc bilinmiyor demektir (checker yok veya hata olustu); bu durumda alan hatasi uretilmez.
+func lookup(ctx context.Context, fl validator.FieldLevel) (found bool, ok bool) {
+	if checker == nil {
+		return false, false
+	}
+
+	state, _ := ctx.Value(asyncStateKey{}).(*asyncState)
+
+	table, column, valid := strings.Cut(fl.Param(), ".")
+	if !valid || table == "" || column == "" {
+		state.set(fmt.Errorf("validation: invalid %s parameter %q, expected table.column", fl.GetTag(), fl.Param()))
+		return false, false
+	}
+
+	// iptal edilmis istek icin sorgu atmaya gerek yok
+	if err := ctx.Err(); err != nil {
+		state.set(err)
+		return false, false
+	}
+
+	found, err := checker.Exists(ctx, table, column, fl.Field().Interface())
+	if err != nil {
+		state.set(err)
+		return false, false
+	}
+
+	return found, true
+}
+
+func (s *asyncState) set(err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// ValidateAsync: obj uzerindeki "async" tag kurallarini request context'i ile calistirir.
+// Alan hatalari validator.ValidationErrors olarak doner (MapValidationErrors ile map'lenebilir),
+// DB hatasi veya context iptali ise oldugu gibi doner.
+func ValidateAsync(ctx context.Context, obj any) error {
+	if asyncValidate == nil {
+		return errors.New("validation: Init must be called before ValidateAsync")
+	}
+
+	state := &asyncState{}
+	err := asyncValidate.StructCtx(context.WithValue(ctx, asyncStateKey{}, state), obj)
+
+	if state.err != nil {
+		return state.err
+	}
+
+	return err
+}
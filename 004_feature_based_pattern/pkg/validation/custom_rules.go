@@ -0,0 +1,232 @@
+package validation
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"feature-base-starter-kit/pkg/slug"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Turkiye'ye ozgu ve genel amacli custom kurallar.
+// Kullanim: binding:"required,tckn" veya binding:"omitempty,phone_tr"
+// Hata mesajlari locales/<dil>.yaml dosyalarindadir.
+func init() {
+	MustRegisterRule(Rule{Tag: "tckn", Func: isTCKN})
+	MustRegisterRule(Rule{Tag: "vkn", Func: isVKN})
+	MustRegisterRule(Rule{Tag: "iban_tr", Func: isTurkishIBAN})
+	MustRegisterRule(Rule{Tag: "phone_tr", Func: isTurkishPhone})
+	MustRegisterRule(Rule{Tag: "slug", Func: isSlug})
+	MustRegisterRule(Rule{Tag: "sluggable", Func: isSluggable})
+	MustRegisterRule(Rule{Tag: "strong_password", Func: isStrongPassword})
+	MustRegisterRule(Rule{Tag: "not_disposable_email", Func: isNotDisposableEmail})
+}
+
+// isTCKN: 11 haneli, 0 ile baslamayan ve 10. ile 11. hane kontrol algoritmasini saglayan numara.
+func isTCKN(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if len(s) != 11 || s[0] == '0' {
+		return false
+	}
+
+	d := make([]int, 11)
+	for i, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+		d[i] = int(r - '0')
+	}
+
+	odd := d[0] + d[2] + d[4] + d[6] + d[8]
+	even := d[1] + d[3] + d[5] + d[7]
+
+	// (odd*7 - even) negatif olabilir, bu yuzden mod sonucunu pozitife cekiyoruz
+	if ((odd*7-even)%10+10)%10 != d[9] {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += d[i]
+	}
+
+	return sum%10 == d[10]
+}
+
+// isVKN: 10 haneli vergi kimlik numarasi kontrol algoritmasi.
+func isVKN(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if len(s) != 10 {
+		return false
+	}
+
+	d := make([]int, 10)
+	for i, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+		d[i] = int(r - '0')
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		tmp := (d[i] + 9 - i) % 10
+		v := (tmp * (1 << (9 - i))) % 9
+		if tmp != 0 && v == 0 {
+			v = 9
+		}
+		sum += v
+	}
+
+	return (10-sum%10)%10 == d[9]
+}
+
+// isTurkishIBAN: "TR" + 24 hane (bosluklara izin verilir) ve ISO 13616 mod-97 kontrolu.
+func isTurkishIBAN(fl validator.FieldLevel) bool {
+	s := strings.ToUpper(strings.ReplaceAll(fl.Field().String(), " ", ""))
+	if len(s) != 26 || !strings.HasPrefix(s, "TR") {
+		return false
+	}
+
+	for _, r := range s[2:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	// ilk 4 karakter sona tasinir, harfler sayiya cevrilir (A=10, ..., Z=35)
+	rearranged := s[4:] + s[:4]
+
+	var b strings.Builder
+	for _, r := range rearranged {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteString(strconv.Itoa(int(r-'A') + 10))
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	n, ok := new(big.Int).SetString(b.String(), 10)
+	if !ok {
+		return false
+	}
+
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+
+// isTurkishPhone: +90, 90 veya 0 onekli ya da oneksiz 10 haneli sabit hat / cep telefonu numarasi.
+func isTurkishPhone(fl validator.FieldLevel) bool {
+	s := phoneSeparators.Replace(fl.Field().String())
+
+	switch {
+	case strings.HasPrefix(s, "+90"):
+		s = s[3:]
+	case len(s) == 12 && strings.HasPrefix(s, "90"):
+		s = s[2:]
+	case len(s) == 11 && strings.HasPrefix(s, "0"):
+		s = s[1:]
+	}
+
+	if len(s) != 10 {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	// 5xx: cep telefonu, 2xx/3xx/4xx: sabit hat, 8xx: ozel servis numaralari
+	switch s[0] {
+	case '2', '3', '4', '5', '8':
+		return true
+	}
+
+	return false
+}
+
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+func isSlug(fl validator.FieldLevel) bool {
+	return slugRegex.MatchString(fl.Field().String())
+}
+
+// isSluggable: slug.Make ile bos olmayan bir slug uretilebilen metin (orn: etiket isimleri, bkz. article.normalizeTags).
+func isSluggable(fl validator.FieldLevel) bool {
+	return slug.Make(fl.Field().String()) != ""
+}
+
+func isStrongPassword(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if len([]rune(s)) < 8 {
+		return false
+	}
+
+	var upper, lower, digit, special bool
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			special = true
+		}
+	}
+
+	return upper && lower && digit && special
+}
+
+// disposableMu: disposableDomains istek goroutine'lerinde okunur, AddDisposableDomains ile calisirken de genisletilebilir.
+var disposableMu sync.RWMutex
+
+var disposableDomains = map[string]struct{}{
+	"mailinator.com":    {},
+	"guerrillamail.com": {},
+	"10minutemail.com":  {},
+	"tempmail.com":      {},
+	"temp-mail.org":     {},
+	"yopmail.com":       {},
+	"trashmail.com":     {},
+	"getnada.com":       {},
+	"dispostable.com":   {},
+	"sharklasers.com":   {},
+	"throwawaymail.com": {},
+	"maildrop.cc":       {},
+}
+
+// AddDisposableDomains: not_disposable_email kuralinin engelledigi domain listesine ekleme yapar.
+// Sunucu calisirken de cagrilabilir.
+func AddDisposableDomains(domains ...string) {
+	disposableMu.Lock()
+	defer disposableMu.Unlock()
+
+	for _, d := range domains {
+		disposableDomains[strings.ToLower(strings.TrimSpace(d))] = struct{}{}
+	}
+}
+
+func isNotDisposableEmail(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+
+	at := strings.LastIndex(s, "@")
+	if at == -1 {
+		// format kontrolu "email" kuralinin isi
+		return true
+	}
+
+	disposableMu.RLock()
+	_, disposable := disposableDomains[strings.ToLower(s[at+1:])]
+	disposableMu.RUnlock()
+	return !disposable
+}
@@ -0,0 +1,92 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// newRuleValidator: sadece verilen kurali iceren, Init'ten bagimsiz bir engine.
+func newRuleValidator(t *testing.T, tag string, fn validator.Func) *validator.Validate {
+	t.Helper()
+
+	v := validator.New()
+	if err := v.RegisterValidation(tag, fn); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestChecksumRules(t *testing.T) {
+	tests := []struct {
+		tag   string
+		fn    validator.Func
+		value string
+		valid bool
+	}{
+		{"tckn", isTCKN, "10000000146", true},
+		{"tckn", isTCKN, "12345678950", true},
+		{"tckn", isTCKN, "39141777694", true},
+		{"tckn", isTCKN, "10000000147", false}, // 11. hane yanlis
+		{"tckn", isTCKN, "10000000156", false}, // 10. hane yanlis
+		{"tckn", isTCKN, "01234567890", false}, // 0 ile baslayamaz
+		{"tckn", isTCKN, "1000000014", false},  // 10 hane
+		{"tckn", isTCKN, "1000000014a", false},
+		{"tckn", isTCKN, "", false},
+
+		{"vkn", isVKN, "1234567890", true},
+		{"vkn", isVKN, "4840847211", true},
+		{"vkn", isVKN, "9876543217", true},
+		{"vkn", isVKN, "1234567891", false},
+		{"vkn", isVKN, "9000000001", false},
+		{"vkn", isVKN, "123456789", false},
+		{"vkn", isVKN, "12345678a0", false},
+
+		{"iban_tr", isTurkishIBAN, "TR330006100519786457841326", true},
+		{"iban_tr", isTurkishIBAN, "TR32 0010 0099 9990 1234 5678 90", true},
+		{"iban_tr", isTurkishIBAN, "tr330006100519786457841326", true},
+		{"iban_tr", isTurkishIBAN, "TR330006100519786457841327", false}, // mod-97 tutmuyor
+		{"iban_tr", isTurkishIBAN, "TR33000610051978645784132", false},  // 25 karakter
+		{"iban_tr", isTurkishIBAN, "DE89370400440532013000", false},
+		{"iban_tr", isTurkishIBAN, "TR33000610051978645784132X", false},
+
+		{"phone_tr", isTurkishPhone, "+90 532 123 45 67", true},
+		{"phone_tr", isTurkishPhone, "905321234567", true},
+		{"phone_tr", isTurkishPhone, "0 (212) 555-12-34", true},
+		{"phone_tr", isTurkishPhone, "5321234567", true},
+		{"phone_tr", isTurkishPhone, "0850.123.45.67", true},
+		{"phone_tr", isTurkishPhone, "0132 123 45 67", false}, // 1xx alan kodu yok
+		{"phone_tr", isTurkishPhone, "0532 123 45 6", false},
+		{"phone_tr", isTurkishPhone, "+1 532 123 45 67", false},
+		{"phone_tr", isTurkishPhone, "0532 ABC 45 67", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+"/"+tt.value, func(t *testing.T) {
+			err := newRuleValidator(t, tt.tag, tt.fn).Var(tt.value, tt.tag)
+			if valid := err == nil; valid != tt.valid {
+				t.Errorf("valid = %v, want %v", valid, tt.valid)
+			}
+		})
+	}
+}
+
+// AddDisposableDomains istekler kontrol edilirken cagrilabilir; -race ile calistirilmalidir.
+func TestAddDisposableDomainsConcurrently(t *testing.T) {
+	v := newRuleValidator(t, "not_disposable_email", isNotDisposableEmail)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() { AddDisposableDomains(fmt.Sprintf("Spam%d.example ", i)) })
+		wg.Go(func() { _ = v.Var("someone@example.com", "not_disposable_email") })
+	}
+	wg.Wait()
+
+	if err := v.Var("someone@spam3.example", "not_disposable_email"); err == nil {
+		t.Error("added domain is not blocked")
+	}
+	if err := v.Var("someone@example.com", "not_disposable_email"); err != nil {
+		t.Errorf("regular domain rejected: %v", err)
+	}
+}
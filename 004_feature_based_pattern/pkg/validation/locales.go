@@ -0,0 +1,285 @@
+package validation
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"github.com/goccy/go-yaml"
+)
+
+// Mesajlar locales/<dil>.yaml dosyalarindan okunur. Dosyalar binary'ye gomuludur (embed),
+// SetLocalesDir ile verilen dizindeki dosyalar ise gomulu dosyalarin uzerine anahtar bazinda yazilir.
+//
+//go:embed locales/*.yaml
+var embeddedLocales embed.FS
+
+var localesDir string
+
+// catalog: dil -> anahtar -> mesaj
+var catalog map[string]map[string]message
+
+// message: duz metin (text) veya cogul formlar (forms: "one", "few", "many", "other" ...).
+type message struct {
+	text  string
+	forms map[string]string
+}
+
+type localeFile struct {
+	Validation map[string]any `yaml:"validation"`
+}
+
+// SetLocalesDir: Init'ten once cagrilmalidir. Dizindeki <dil>.yaml dosyalari gomulu mesajlarin uzerine yazilir.
+func SetLocalesDir(dir string) {
+	localesDir = dir
+}
+
+// RegisterLocale: yeni bir dil ekler (orn: fr.New()). Init'ten once cagrilmalidir;
+// mesajlar locales/<dil>.yaml dosyasindan gelir.
+func RegisterLocale(l locales.Translator) {
+	for _, existing := range supportedLocales {
+		if existing.Locale() == l.Locale() {
+			return
+		}
+	}
+	supportedLocales = append(supportedLocales, l)
+}
+
+func loadCatalog() (map[string]map[string]message, error) {
+	out := make(map[string]map[string]message)
+
+	if err := readLocales(embeddedLocales, "locales", out); err != nil {
+		return out, err
+	}
+
+	if localesDir != "" {
+		if err := readLocales(os.DirFS(localesDir), ".", out); err != nil {
+			return out, err
+		}
+	}
+
+	return out, nil
+}
+
+func readLocales(fsys fs.FS, dir string, out map[string]map[string]message) error {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.yaml"))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range files {
+		lang := strings.TrimSuffix(path.Base(name), ".yaml")
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		var file localeFile
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if out[lang] == nil {
+			out[lang] = make(map[string]message)
+		}
+
+		for key, raw := range file.Validation {
+			msg, err := parseMessage(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %w", name, key, err)
+			}
+			out[lang][key] = msg
+		}
+	}
+
+	return nil
+}
+
+func parseMessage(raw any) (message, error) {
+	switch v := raw.(type) {
+	case string:
+		return message{text: v}, nil
+	case map[string]any:
+		forms := make(map[string]string, len(v))
+		for form, text := range v {
+			s, ok := text.(string)
+			if !ok {
+				return message{}, fmt.Errorf("plural form %q must be a string", form)
+			}
+			forms[strings.ToLower(form)] = s
+		}
+		return message{forms: forms}, nil
+	}
+
+	return message{}, fmt.Errorf("unsupported message type %T", raw)
+}
+
+// catalogHasTag: dilin dosyasinda tag (veya tag.string gibi tipe ozel bir anahtar) tanimli mi?
+func catalogHasTag(lang, tag string) bool {
+	for key := range catalog[lang] {
+		if key == tag || strings.HasPrefix(key, tag+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyCatalog: dosyalardaki mesajlari, her dil icin validator'a ceviri olarak kaydeder.
+func applyCatalog(v *validator.Validate) {
+	for lang, trans := range translators {
+		tags := make(map[string]struct{})
+		for key := range catalog[lang] {
+			tag, _, _ := strings.Cut(key, ".")
+			tags[tag] = struct{}{}
+		}
+
+		for tag := range tags {
+			err := v.RegisterTranslation(tag, trans,
+				func(ut.Translator) error { return nil },
+				func(t ut.Translator, fe validator.FieldError) string {
+					return translateFromCatalog(lang, t, fe)
+				},
+			)
+			if err != nil {
+				log.Printf("Error registering %s translation for %q: %v", lang, tag, err)
+			}
+		}
+	}
+}
+
+func translateFromCatalog(lang string, t ut.Translator, fe validator.FieldError) string {
+	msgs := catalog[lang]
+
+	msg, ok := msgs[fe.Tag()+"."+kindName(fe)]
+	if !ok {
+		msg, ok = msgs[fe.Tag()]
+	}
+	if !ok {
+		return fe.Error()
+	}
+
+	text := msg.text
+	if msg.forms != nil {
+		text = msg.pluralForm(t, fe.Param())
+	}
+
+	return strings.NewReplacer("{0}", fe.Field(), "{1}", fe.Param()).Replace(text)
+}
+
+// pluralForm: parametredeki sayiya gore, dilin cogul kuralini (ut) kullanarak formu secer.
+func (m message) pluralForm(t ut.Translator, param string) string {
+	num, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return m.forms["other"]
+	}
+
+	var digits uint64
+	if idx := strings.Index(param, "."); idx != -1 {
+		digits = uint64(len(param[idx+1:]))
+	}
+
+	rule := t.CardinalPluralRule(num, digits)
+	if text, ok := m.forms[strings.ToLower(rule.String())]; ok {
+		return text
+	}
+
+	return m.forms["other"]
+}
+
+// kindName: alanin tipine gore mesaj anahtari eki ("string", "number", "items").
+func kindName(fe validator.FieldError) string {
+	kind := fe.Kind()
+	if kind == reflect.Ptr {
+		kind = fe.Type().Elem().Kind()
+	}
+
+	switch kind {
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return "items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+
+	return ""
+}
+
+// CheckLocales: locale dosyalarini (gomulu + SetLocalesDir) okur ve eksik anahtarlari raporlar.
+func CheckLocales() (map[string][]string, error) {
+	cat, err := loadCatalog()
+	if err != nil {
+		return nil, err
+	}
+	return missingKeys(cat), nil
+}
+
+// MissingKeys: Init ile yuklenen mesajlarda, herhangi bir dilde olup digerlerinde eksik olan anahtarlari dondurur.
+func MissingKeys() map[string][]string {
+	return missingKeys(catalog)
+}
+
+// missingKeys: tum dillerdeki anahtarlarin birlesimini her dil ile karsilastirir.
+// Cogul mesajlarda dilin gerektirdigi formlar da kontrol edilir, eksik form "min.string[few]" seklinde raporlanir.
+func missingKeys(cat map[string]map[string]message) map[string][]string {
+	all := make(map[string]struct{})
+	for _, msgs := range cat {
+		for key := range msgs {
+			all[key] = struct{}{}
+		}
+	}
+
+	langs := make(map[string]locales.Translator)
+	for _, l := range supportedLocales {
+		langs[l.Locale()] = l
+	}
+	for lang := range cat {
+		if _, ok := langs[lang]; !ok {
+			langs[lang] = nil // desteklenmeyen dil: sadece anahtarlar kontrol edilir
+		}
+	}
+
+	out := make(map[string][]string)
+	for lang, loc := range langs {
+		var missing []string
+
+		for key := range all {
+			msg, ok := cat[lang][key]
+			if !ok {
+				missing = append(missing, key)
+				continue
+			}
+
+			if msg.forms == nil || loc == nil {
+				continue
+			}
+
+			for _, rule := range loc.PluralsCardinal() {
+				form := strings.ToLower(rule.String())
+				if _, ok := msg.forms[form]; !ok {
+					missing = append(missing, key+"["+form+"]")
+				}
+			}
+		}
+
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			out[lang] = missing
+		}
+	}
+
+	return out
+}
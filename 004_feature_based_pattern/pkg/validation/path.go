@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// PathStyle: hata map'indeki alan yolunun (key) formatini belirler.
+type PathStyle string
+
+const (
+	// PathStyleDot: seo_settings.keywords[2], categories[0].id (varsayilan)
+	PathStyleDot PathStyle = "dot"
+	// PathStylePointer: JSON Pointer (RFC 6901), /seo_settings/keywords/2, /categories/0/id
+	PathStylePointer PathStyle = "pointer"
+	// PathStyleBracket: seo_settings[keywords][2], categories[0][id] (form alan adi formati)
+	PathStyleBracket PathStyle = "bracket"
+)
+
+var pathStyle = PathStyleDot
+
+// SetPathStyle: MapValidationErrors'in kullanacagi alan yolu formatini ayarlar. Bilinmeyen deger gelirse PathStyleDot kullanilir.
+func SetPathStyle(style PathStyle) {
+	switch style {
+	case PathStyleDot, PathStylePointer, PathStyleBracket:
+		pathStyle = style
+	default:
+		pathStyle = PathStyleDot
+	}
+}
+
+// FieldPath: hatali alanin, request govdesindeki tam yolunu secili formatta dondurur.
+// fe.Namespace() register edilen json tag isimleriyle olusur (orn: "CreateArticleRequest.seo_settings.keywords[2]"),
+// ilk parca struct adi oldugu icin atilir.
+func FieldPath(fe validator.FieldError) string {
+	segments := splitNamespace(fe.Namespace())
+	if len(segments) > 1 {
+		segments = segments[1:]
+	}
+
+	if len(segments) == 0 {
+		return fe.Field()
+	}
+
+	return formatPath(segments, pathStyle)
+}
+
+// Path: validator disinda uretilen alan hatalari icin (orn: handler'daki is kurallari) ust seviye alan adini secili formatta dondurur.
+func Path(field string) string {
+	return formatPath([]pathSegment{{name: field}}, pathStyle)
+}
+
+// pathSegment: yolun tek bir parcasi. index=true ise slice/map erisimidir ([2], [key]).
+type pathSegment struct {
+	name  string
+	index bool
+}
+
+func splitNamespace(ns string) []pathSegment {
+	var segments []pathSegment
+
+	for ns != "" {
+		switch ns[0] {
+		case '.':
+			ns = ns[1:]
+		case '[':
+			end := strings.IndexByte(ns, ']')
+			if end == -1 {
+				segments = append(segments, pathSegment{name: ns[1:], index: true})
+				return segments
+			}
+			segments = append(segments, pathSegment{name: ns[1:end], index: true})
+			ns = ns[end+1:]
+		default:
+			end := strings.IndexAny(ns, ".[")
+			if end == -1 {
+				end = len(ns)
+			}
+			segments = append(segments, pathSegment{name: ns[:end]})
+			ns = ns[end:]
+		}
+	}
+
+	return segments
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func formatPath(segments []pathSegment, style PathStyle) string {
+	var b strings.Builder
+
+	for i, seg := range segments {
+		switch style {
+		case PathStylePointer:
+			b.WriteByte('/')
+			b.WriteString(pointerEscaper.Replace(seg.name))
+		case PathStyleBracket:
+			if i == 0 {
+				b.WriteString(seg.name)
+				continue
+			}
+			b.WriteByte('[')
+			b.WriteString(seg.name)
+			b.WriteByte(']')
+		default:
+			if seg.index {
+				b.WriteByte('[')
+				b.WriteString(seg.name)
+				b.WriteByte(']')
+				continue
+			}
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(seg.name)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"errors"
+	"sync"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Rule: binding tag'i ile kullanilabilen custom validation kurali.
+// Messages alanindaki metinlerde {0} alan adini, {1} kural parametresini temsil eder.
+// locales/<dil>.yaml dosyasinda ayni tag icin mesaj varsa, dosyadaki mesaj kullanilir.
+//
+// Ornek:
+//
+//	validation.RegisterRule(validation.Rule{
+//		Tag:  "even",
+//		Func: func(fl validator.FieldLevel) bool { return fl.Field().Int()%2 == 0 },
+//		Messages: map[string]string{
+//			"en": "{0} must be an even number",
+//			"tr": "{0} çift sayı olmalıdır",
+//		},
+//	})
+type Rule struct {
+	Tag        string
+	Func       validator.Func
+	CallIfNull bool              // alan bos (nil) olsa bile kural calistirilsin mi
+	Messages   map[string]string // dil kodu -> mesaj ("tr", "en", "ru", ...)
+}
+
+var (
+	rulesMu sync.Mutex // rules, validate ve engine'e kayit islemleri (bkz. Init)
+	rules   []Rule
+)
+
+// RegisterRule: yeni bir kurali kaydeder. Init'ten once cagrilirsa kural kuyrukta bekler ve Init sirasinda uygulanir,
+// Init'ten sonra cagrilirsa dogrudan engine'e eklenir. Boylece moduller validation.Init'i degistirmeden kendi kurallarini ekleyebilir.
+func RegisterRule(r Rule) error {
+	if r.Tag == "" || r.Func == nil {
+		return errors.New("validation: rule tag and func are required")
+	}
+
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	rules = append(rules, r)
+
+	if validate == nil {
+		return nil
+	}
+	return applyRule(r)
+}
+
+// MustRegisterRule: RegisterRule gibidir fakat hata durumunda panic eder. Paket init() fonksiyonlarinda kullanmak icin.
+func MustRegisterRule(r Rule) {
+	if err := RegisterRule(r); err != nil {
+		panic(err)
+	}
+}
+
+func applyRule(r Rule) error {
+	if err := validate.RegisterValidation(r.Tag, r.Func, r.CallIfNull); err != nil {
+		return err
+	}
+
+	for lang, trans := range translators {
+		// locales/<dil>.yaml icinde bu tag icin mesaj varsa, o mesaj onceliklidir
+		if catalogHasTag(lang, r.Tag) {
+			continue
+		}
+
+		msg := ruleMessage(r, lang)
+		if msg == "" {
+			// ceviri yoksa, validator'in varsayilan hata metni kullanilir
+			continue
+		}
+
+		err := validate.RegisterTranslation(r.Tag, trans,
+			func(t ut.Translator) error {
+				return t.Add(r.Tag, msg, true)
+			},
+			func(t ut.Translator, fe validator.FieldError) string {
+				s, err := t.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					return fe.Error()
+				}
+				return s
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ruleMessage: istenen dildeki mesaji, yoksa Ingilizce mesaji dondurur.
+func ruleMessage(r Rule, lang string) string {
+	if msg, ok := r.Messages[lang]; ok {
+		return msg
+	}
+	return r.Messages["en"]
+}
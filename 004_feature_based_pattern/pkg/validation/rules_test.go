@@ -0,0 +1,38 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Init ile eszamanli kaydedilen kurallar (Init oncesi kuyruga veya sonrasinda dogrudan engine'e) kaybolmamali.
+// Veri yarislari icin -race ile calistirilmalidir.
+func TestRegisterRuleDuringInit(t *testing.T) {
+	const n = 20
+
+	var wg sync.WaitGroup
+	wg.Go(func() { Init("en") })
+	for i := range n {
+		wg.Go(func() {
+			MustRegisterRule(Rule{
+				Tag:      fmt.Sprintf("concurrent_%d", i),
+				Func:     func(fl validator.FieldLevel) bool { return fl.Field().String() == "ok" },
+				Messages: map[string]string{"en": "{0} must be ok"},
+			})
+		})
+	}
+	wg.Wait()
+
+	for i := range n {
+		tag := fmt.Sprintf("concurrent_%d", i)
+		if err := validate.Var("ok", tag); err != nil {
+			t.Errorf("%s: valid value rejected: %v", tag, err)
+		}
+		if err := validate.Var("no", tag); err == nil {
+			t.Errorf("%s: rule was not registered", tag)
+		}
+	}
+}
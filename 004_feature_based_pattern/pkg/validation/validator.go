@@ -6,17 +6,56 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/ar"
+	"github.com/go-playground/locales/az"
+	"github.com/go-playground/locales/de"
 	"github.com/go-playground/locales/en"
 	"github.com/go-playground/locales/ru"
 	"github.com/go-playground/locales/tr"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
+	arTranslations "github.com/go-playground/validator/v10/translations/ar"
+	deTranslations "github.com/go-playground/validator/v10/translations/de"
 	enTranslations "github.com/go-playground/validator/v10/translations/en"
 	ruTranslations "github.com/go-playground/validator/v10/translations/ru"
 	trTranslations "github.com/go-playground/validator/v10/translations/tr"
 )
 
-var translator ut.Translator
+var (
+	translator  ut.Translator            // varsayilan dil (APP_LANG)
+	translators map[string]ut.Translator // desteklenen tum diller, dil kodu -> translator
+	validate    *validator.Validate      // Init sonrasi kullanilan validator engine
+)
+
+// supportedLocales: desteklenen diller. Ilk eleman fallback dildir.
+// Yeni bir dil eklemek icin buraya locale, locales/ altina da <dil>.yaml eklenmelidir.
+var supportedLocales = []locales.Translator{
+	en.New(),
+	tr.New(),
+	ru.New(),
+	de.New(),
+	ar.New(),
+	az.New(),
+}
+
+// defaultTranslations: go-playground'un hazir cevirisi bulunan diller. az icin hazir ceviri yok, mesajlar locales/az.yaml'dan gelir.
+var defaultTranslations = map[string]func(*validator.Validate, ut.Translator) error{
+	"en": enTranslations.RegisterDefaultTranslations,
+	"tr": trTranslations.RegisterDefaultTranslations,
+	"ru": ruTranslations.RegisterDefaultTranslations,
+	"de": deTranslations.RegisterDefaultTranslations,
+	"ar": arTranslations.RegisterDefaultTranslations,
+}
+
+// SupportedLanguages: desteklenen dil kodlarini dondurur ("en", "tr", "ru", "de", "ar", "az").
+func SupportedLanguages() []string {
+	out := make([]string, 0, len(supportedLocales))
+	for _, l := range supportedLocales {
+		out = append(out, l.Locale())
+	}
+	return out
+}
 
 func Init(lang string) *validator.Validate {
 	// Override default validator engine
@@ -25,31 +64,30 @@ func Init(lang string) *validator.Validate {
 		panic("Validator engine is not found")
 	}
 
-	// tag alanlarini register et
-	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		// json tag'ini al
-		tag := fld.Tag.Get("json")
+	// engine, translators, catalog ve validate, kuyruktaki custom kurallar uygulanana kadar kilit altinda degisir:
+	// eszamanli bir RegisterRule ya kuyruga eklenip burada uygulanir ya da kilidi bekleyip dogrudan engine'e eklenir
+	// (iki kez degil). validator kayit islemleri eszamanli cagrilara karsi guvenli degildir.
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
 
-		if tag == "" {
-			return fld.Name
-		}
-
-		// json tag varsa, virgule kadar olan kismi al
-		name := strings.Split(tag, ",")[0]
-		if name == "-" || name == "" {
-			return fld.Name
-		}
-
-		return name
-	})
+	// tag alanlarini register et
+	v.RegisterTagNameFunc(jsonTagName)
 
 	// Translator init
-	trLocale := tr.New()
-	enLocale := en.New()
-	ruLocale := ru.New()
-
-	// fallback locale: en, supported locales: tr, ru
-	uni := ut.New(enLocale, trLocale, enLocale, ruLocale)
+	// fallback locale: en, supported locales: supportedLocales
+	uni := ut.New(supportedLocales[0], supportedLocales...)
+
+	translators = make(map[string]ut.Translator, len(supportedLocales))
+	for _, l := range supportedLocales {
+		trans, _ := uni.GetTranslator(l.Locale())
+		translators[l.Locale()] = trans
+
+		if register, ok := defaultTranslations[l.Locale()]; ok {
+			if err := register(v, trans); err != nil {
+				log.Printf("Error registering %s translations: %v", l.Locale(), err)
+			}
+		}
+	}
 
 	var found bool
 	translator, found = uni.GetTranslator(lang)
@@ -58,31 +96,74 @@ func Init(lang string) *validator.Validate {
 		translator, _ = uni.GetTranslator("en")
 	}
 
+	// locales/*.yaml mesajlari (gomulu + varsa diskteki override'lar)
 	var err error
-	switch lang {
-	case "tr":
-		err = trTranslations.RegisterDefaultTranslations(v, translator)
-	case "ru":
-		err = ruTranslations.RegisterDefaultTranslations(v, translator)
-	default: // "en" veya diğer durumlar için varsayılan İngilizce
-		err = enTranslations.RegisterDefaultTranslations(v, translator)
+	catalog, err = loadCatalog()
+	if err != nil {
+		log.Printf("Error loading locale files: %v", err)
 	}
 
-	if err != nil {
-		log.Printf("Error registering translations: %v", err)
+	initAsync()
+
+	// Init oncesinde kaydedilen custom kurallari engine'e uygula
+	validate = v
+	for _, r := range rules {
+		if err := applyRule(r); err != nil {
+			log.Printf("Error registering rule %q: %v", r.Tag, err)
+		}
+	}
+	applyCatalog(v)
+	applyCatalog(asyncValidate)
+
+	for lang, keys := range MissingKeys() {
+		log.Printf("Locale %q is missing translation keys: %s", lang, strings.Join(keys, ", "))
 	}
 
 	return v
 }
 
+func jsonTagName(fld reflect.StructField) string {
+	// json tag'ini al
+	tag := fld.Tag.Get("json")
+
+	if tag == "" {
+		return fld.Name
+	}
+
+	// json tag varsa, virgule kadar olan kismi al
+	name := strings.Split(tag, ",")[0]
+	if name == "-" || name == "" {
+		return fld.Name
+	}
+
+	return name
+}
+
+// MapValidationErrors: hatalari varsayilan dilde (APP_LANG) map'ler.
 func MapValidationErrors(ve validator.ValidationErrors) map[string][]string {
+	return MapValidationErrorsWith(ve, translator)
+}
+
+// MapValidationErrorsWith: hatalari verilen translator'in dilinde map'ler (orn: istegin diline gore i18n.Localizer).
+func MapValidationErrorsWith(ve validator.ValidationErrors, trans ut.Translator) map[string][]string {
 	out := make(map[string][]string) // ram de map olusturuldu
 
 	for _, fe := range ve {
-		field := fe.Field() // Name, Email, Age
-		msg := fe.Translate(translator)
+		field := FieldPath(fe) // name, email, seo_settings.keywords[2], categories[0].id
+		msg := fe.Translate(trans)
 
 		out[field] = append(out[field], msg)
 	}
 	return out
 }
+
+// Translator: dil koduna ait translator'i dondurur. Init'ten sonra kullanilmalidir.
+func Translator(lang string) (ut.Translator, bool) {
+	trans, ok := translators[lang]
+	return trans, ok
+}
+
+// DefaultTranslator: Init'e verilen (APP_LANG) dilin translator'ini dondurur.
+func DefaultTranslator() ut.Translator {
+	return translator
+}
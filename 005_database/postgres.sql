@@ -205,7 +205,7 @@ VALUES
         'Bu makalede PostgreSQL ve Golang kurulumu anlatılacaktır.',
         '<p>PostgreSQL kurulumu için öncelikle ...</p>',
         TRUE,
-        15,
+        (SELECT id FROM users WHERE email = 'sercan@example.com'),
         '{"meta_title": "PostgreSQL ve Golang Kurulumu", "meta_description": "Bu makalede PostgreSQL ve Golang kurulumu anlatılacaktır.", "keywords": ["postgresql", "golang", "kurulum"]}'
     ),
     (
@@ -214,7 +214,7 @@ VALUES
         'Go programlama dili ile PostgreSQL veritabanı kullanımı hakkında rehber.',
         '<p>Go ile PostgreSQL kullanmak için öncelikle ...</p>',
         FALSE,
-        12,
+        (SELECT id FROM users WHERE email = 'alin@example.com'),
         '{"meta_title": "Go ve PostgreSQL Rehberi", "meta_description": "Go programlama dili ile PostgreSQL veritabanı kullanımı hakkında rehber.", "keywords": ["go", "postgresql", "rehber"]}'
     );
 
@@ -227,10 +227,18 @@ VALUES
     ('Web Geliştirme', 'web-gelistirme');
 
 -- INSERT INTO article_categories ilişki tablosuna örnek veriler ekleme
+-- ID'ler sabit yazilmaz (yeni bir veritabaninda 7, 8 gibi id'ler olmayabilir), slug ile aranir.
+-- Gercekci ve tutarli ornek veri icin: go run ./cmd/api seed (004_feature_based_pattern)
 INSERT INTO
     article_categories (article_id, category_id)
-VALUES
-    (7, 1), -- İlk makale Go Programlama kategorisinde
-    (7, 2), -- İlk makale Veritabanı kategorisinde
-    (8, 1), -- İkinci makale Go Programlama kategorisinde
-    (8, 3); -- İkinci makale Web Geliştirme kategorisinde
\ No newline at end of file
+SELECT a.id, c.id
+FROM (
+    VALUES
+        ('postgresql-ve-golang-kurulumu', 'go-programlama'), -- İlk makale Go Programlama kategorisinde
+        ('postgresql-ve-golang-kurulumu', 'veritabani'), -- İlk makale Veritabanı kategorisinde
+        ('go-ve-postgresql-rehberi', 'go-programlama'), -- İkinci makale Go Programlama kategorisinde
+        ('go-ve-postgresql-rehberi', 'web-gelistirme') -- İkinci makale Web Geliştirme kategorisinde
+) AS v (article_slug, category_slug)
+JOIN articles a ON a.slug = v.article_slug
+JOIN categories c ON c.slug = v.category_slug
+ON CONFLICT DO NOTHING;
\ No newline at end of file